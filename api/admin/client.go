@@ -9,6 +9,7 @@ import (
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/rpc"
@@ -31,6 +32,7 @@ type Client interface {
 	GetLoggerLevel(ctx context.Context, loggerName string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetConfig(ctx context.Context, options ...rpc.Option) (interface{}, error)
 	DBGet(ctx context.Context, key []byte, options ...rpc.Option) ([]byte, error)
+	SignMessage(ctx context.Context, msg []byte, options ...rpc.Option) (*staking.Certificate, []byte, error)
 }
 
 // Client implementation for the Avalanche Platform Info API Endpoint
@@ -163,3 +165,29 @@ func (c *client) DBGet(ctx context.Context, key []byte, options ...rpc.Option) (
 	}
 	return formatting.Decode(formatting.HexNC, res.Value)
 }
+
+func (c *client) SignMessage(ctx context.Context, msg []byte, options ...rpc.Option) (*staking.Certificate, []byte, error) {
+	msgStr, err := formatting.Encode(formatting.HexNC, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &SignMessageReply{}
+	err = c.requester.SendRequest(ctx, "admin.signMessage", &SignMessageArgs{
+		Message: msgStr,
+	}, res, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certBytes, err := formatting.Decode(formatting.HexNC, res.Certificate)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := staking.ParseCertificate(certBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	sig, err := formatting.Decode(formatting.HexNC, res.Signature)
+	return cert, sig, err
+}
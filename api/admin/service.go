@@ -4,13 +4,17 @@
 package admin
 
 import (
+	"crypto"
+	"crypto/rand"
 	"errors"
+	"fmt"
 	"net/http"
 	"path"
 	"sync"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/api/server"
@@ -18,13 +22,16 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/utils/profiler"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/registry"
 
@@ -36,11 +43,26 @@ const (
 
 	// Name of file that stacktraces are written to
 	stacktraceFile = "stacktrace.txt"
+
+	// SignedMessagePrefix is prepended to every message signed by SignMessage
+	// so that the signature can't be confused with a signature over a block
+	// or a handshake.
+	SignedMessagePrefix = "Avalanche Signed Message:\n"
+
+	maxSignMessageSize = 64 * units.KiB
+
+	// Allow signing up to [signMessageBurst] messages at once, and
+	// [signMessageRate] messages per second on average.
+	signMessageRate  = 10
+	signMessageBurst = 10
 )
 
 var (
-	errAliasTooLong = errors.New("alias length is too long")
-	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+	errAliasTooLong         = errors.New("alias length is too long")
+	errNoLogLevel           = errors.New("need to specify either displayLevel or logLevel")
+	errNoStakingKey         = errors.New("staking key is not available")
+	errMessageTooLarge      = errors.New("message is too large")
+	errSignMessageThrottled = errors.New("too many sign message requests")
 )
 
 type Config struct {
@@ -53,13 +75,18 @@ type Config struct {
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	// StakingSigner and StakingCert are the node's staking key and
+	// certificate. They are used to attest to messages in SignMessage.
+	StakingSigner crypto.Signer
+	StakingCert   *staking.Certificate
 }
 
 // Admin is the API service for node admin management
 type Admin struct {
 	Config
-	lock     sync.RWMutex
-	profiler profiler.Profiler
+	lock               sync.RWMutex
+	profiler           profiler.Profiler
+	signMessageLimiter *rate.Limiter
 }
 
 // NewService returns a new admin API service.
@@ -71,8 +98,9 @@ func NewService(config Config) (http.Handler, error) {
 	server.RegisterCodec(codec, "application/json;charset=UTF-8")
 	return server, server.RegisterService(
 		&Admin{
-			Config:   config,
-			profiler: profiler.New(config.ProfileDir),
+			Config:             config,
+			profiler:           profiler.New(config.ProfileDir),
+			signMessageLimiter: rate.NewLimiter(signMessageRate, signMessageBurst),
 		},
 		"admin",
 	)
@@ -413,3 +441,65 @@ func (a *Admin) DbGet(_ *http.Request, args *DBGetArgs, reply *DBGetReply) error
 	reply.Value, err = formatting.Encode(formatting.HexNC, value)
 	return err
 }
+
+type SignMessageArgs struct {
+	// Message is the hex encoded message to sign
+	Message string `json:"message"`
+}
+
+type SignMessageReply struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Certificate is the hex encoded staking certificate of the node
+	Certificate string `json:"certificate"`
+	// Signature is the hex encoded signature of [SignedMessagePrefix] followed
+	// by the message
+	Signature string `json:"signature"`
+}
+
+// SignMessage signs the provided message with the node's staking key so that
+// off-chain systems can verify that this node attested to the message.
+func (a *Admin) SignMessage(_ *http.Request, args *SignMessageArgs, reply *SignMessageReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "signMessage"),
+	)
+
+	if a.StakingSigner == nil || a.StakingCert == nil {
+		return errNoStakingKey
+	}
+	if !a.signMessageLimiter.Allow() {
+		return errSignMessageThrottled
+	}
+
+	msg, err := formatting.Decode(formatting.HexNC, args.Message)
+	if err != nil {
+		return err
+	}
+	if len(msg) > maxSignMessageSize {
+		return fmt.Errorf("%w: %d > %d", errMessageTooLarge, len(msg), maxSignMessageSize)
+	}
+
+	msgHash := hashing.ComputeHash256(signedMessage(msg))
+	sig, err := a.StakingSigner.Sign(rand.Reader, msgHash, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to sign message: %w", err)
+	}
+
+	reply.NodeID = ids.NodeIDFromCert(a.StakingCert)
+	reply.Certificate, err = formatting.Encode(formatting.HexNC, a.StakingCert.Raw)
+	if err != nil {
+		return err
+	}
+	reply.Signature, err = formatting.Encode(formatting.HexNC, sig)
+	return err
+}
+
+// VerifySignedMessage returns nil iff [sig] is a signature produced by
+// SignMessage over [msg] by the owner of [cert].
+func VerifySignedMessage(cert *staking.Certificate, msg []byte, sig []byte) error {
+	return staking.CheckSignature(cert, signedMessage(msg), sig)
+}
+
+func signedMessage(msg []byte) []byte {
+	return append([]byte(SignedMessagePrefix), msg...)
+}
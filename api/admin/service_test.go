@@ -4,14 +4,17 @@
 package admin
 
 import (
+	"crypto"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/time/rate"
 
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms"
@@ -167,3 +170,106 @@ func TestServiceDBGet(t *testing.T) {
 		})
 	}
 }
+
+func TestSignMessage(t *testing.T) {
+	require := require.New(t)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(err)
+
+	a := &Admin{
+		Config: Config{
+			Log:           logging.NoLog{},
+			StakingSigner: tlsCert.PrivateKey.(crypto.Signer),
+			StakingCert:   cert,
+		},
+		signMessageLimiter: rate.NewLimiter(signMessageRate, signMessageBurst),
+	}
+
+	msg := []byte("hello")
+	msgHex, err := formatting.Encode(formatting.HexNC, msg)
+	require.NoError(err)
+
+	reply := &SignMessageReply{}
+	require.NoError(a.SignMessage(
+		nil,
+		&SignMessageArgs{
+			Message: msgHex,
+		},
+		reply,
+	))
+	require.Equal(ids.NodeIDFromCert(cert), reply.NodeID)
+
+	certBytes, err := formatting.Decode(formatting.HexNC, reply.Certificate)
+	require.NoError(err)
+	require.Equal(cert.Raw, certBytes)
+
+	sig, err := formatting.Decode(formatting.HexNC, reply.Signature)
+	require.NoError(err)
+	require.NoError(VerifySignedMessage(cert, msg, sig))
+
+	// The signature must not be valid for the raw message
+	require.ErrorIs(staking.CheckSignature(cert, msg, sig), staking.ErrECDSAVerificationFailure)
+}
+
+func TestSignMessageLimits(t *testing.T) {
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(t, err)
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(t, err)
+
+	largeMsgHex, err := formatting.Encode(formatting.HexNC, make([]byte, maxSignMessageSize+1))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		signer      crypto.Signer
+		limiter     *rate.Limiter
+		message     string
+		expectedErr error
+	}{
+		{
+			name:        "no staking key",
+			limiter:     rate.NewLimiter(signMessageRate, signMessageBurst),
+			message:     "0x",
+			expectedErr: errNoStakingKey,
+		},
+		{
+			name:        "message too large",
+			signer:      tlsCert.PrivateKey.(crypto.Signer),
+			limiter:     rate.NewLimiter(signMessageRate, signMessageBurst),
+			message:     largeMsgHex,
+			expectedErr: errMessageTooLarge,
+		},
+		{
+			name:        "throttled",
+			signer:      tlsCert.PrivateKey.(crypto.Signer),
+			limiter:     rate.NewLimiter(0, 0),
+			message:     "0x",
+			expectedErr: errSignMessageThrottled,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := &Admin{
+				Config: Config{
+					Log:           logging.NoLog{},
+					StakingSigner: test.signer,
+					StakingCert:   cert,
+				},
+				signMessageLimiter: test.limiter,
+			}
+
+			err := a.SignMessage(
+				nil,
+				&SignMessageArgs{
+					Message: test.message,
+				},
+				&SignMessageReply{},
+			)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
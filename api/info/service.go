@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/json"
@@ -289,6 +290,29 @@ func (i *Info) IsBootstrapped(_ *http.Request, args *IsBootstrappedArgs, reply *
 	return nil
 }
 
+// GetChainsReply are the results from calling GetChains
+type GetChainsReply struct {
+	// Loaded are the chains that are running
+	Loaded []ids.ID `json:"loaded"`
+	// Unloaded are the chains that were shut down for being idle
+	Unloaded []ids.ID `json:"unloaded"`
+}
+
+// GetChains returns the chains that are running on this node and the chains
+// that were shut down for being idle
+func (i *Info) GetChains(_ *http.Request, _ *struct{}, reply *GetChainsReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getChains"),
+	)
+
+	reply.Loaded = i.chainManager.LoadedChains()
+	utils.Sort(reply.Loaded)
+	reply.Unloaded = i.chainManager.UnloadedChains()
+	utils.Sort(reply.Unloaded)
+	return nil
+}
+
 // UptimeResponse are the results from calling Uptime
 type UptimeResponse struct {
 	// RewardingStakePercentage shows what percent of network stake thinks we're
@@ -31,6 +31,11 @@ type MultiGatherer interface {
 	// Register adds the outputs of [gatherer] to the results of future calls to
 	// Gather with the provided [namespace] added to the metrics.
 	Register(namespace string, gatherer prometheus.Gatherer) error
+
+	// Deregister removes the outputs of the gatherer registered with the
+	// provided [namespace] from the results of future calls to Gather.
+	// Returns true if a gatherer was removed.
+	Deregister(namespace string) bool
 }
 
 type multiGatherer struct {
@@ -88,6 +93,15 @@ func (g *multiGatherer) Register(namespace string, gatherer prometheus.Gatherer)
 	return nil
 }
 
+func (g *multiGatherer) Deregister(namespace string) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	_, exists := g.gatherers[namespace]
+	delete(g.gatherers, namespace)
+	return exists
+}
+
 func sortMetrics(m []*dto.MetricFamily) {
 	slices.SortFunc(m, func(i, j *dto.MetricFamily) int {
 		return cmp.Compare(*i.Name, *j.Name)
@@ -37,6 +37,21 @@ func TestMultiGathererDuplicatedPrefix(t *testing.T) {
 	require.NoError(g.Register("lol", og))
 }
 
+func TestMultiGathererDeregister(t *testing.T) {
+	require := require.New(t)
+
+	g := NewMultiGatherer()
+	og := prometheus.NewRegistry()
+
+	require.False(g.Deregister(""))
+
+	require.NoError(g.Register("", og))
+	require.True(g.Deregister(""))
+	require.False(g.Deregister(""))
+
+	require.NoError(g.Register("", og))
+}
+
 func TestMultiGathererAddedError(t *testing.T) {
 	require := require.New(t)
 
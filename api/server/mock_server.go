@@ -13,6 +13,7 @@ import (
 	http "net/http"
 	reflect "reflect"
 
+	ids "github.com/ava-labs/avalanchego/ids"
 	snow "github.com/ava-labs/avalanchego/snow"
 	common "github.com/ava-labs/avalanchego/snow/engine/common"
 	gomock "go.uber.org/mock/gomock"
@@ -107,6 +108,18 @@ func (mr *MockServerMockRecorder) AddRouteWithReadLock(arg0, arg1, arg2 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRouteWithReadLock", reflect.TypeOf((*MockServer)(nil).AddRouteWithReadLock), arg0, arg1, arg2)
 }
 
+// DeregisterChain mocks base method.
+func (m *MockServer) DeregisterChain(arg0 ids.ID, arg1 func()) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeregisterChain", arg0, arg1)
+}
+
+// DeregisterChain indicates an expected call of DeregisterChain.
+func (mr *MockServerMockRecorder) DeregisterChain(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterChain", reflect.TypeOf((*MockServer)(nil).DeregisterChain), arg0, arg1)
+}
+
 // Dispatch mocks base method.
 func (m *MockServer) Dispatch() error {
 	m.ctrl.T.Helper()
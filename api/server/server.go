@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/NYTimes/gziphandler"
@@ -23,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
 )
@@ -65,6 +67,11 @@ type Server interface {
 	// That is, add <route, handler> pairs to server so that API calls can be
 	// made to the VM.
 	RegisterChain(chainName string, ctx *snow.ConsensusContext, vm common.VM)
+	// DeregisterChain stops routing API calls to the VM of this chain, which
+	// is being shut down. Until the chain is registered again, API calls to it
+	// are rejected and, if [onRequest] is non-nil, [onRequest] is called for
+	// each of them.
+	DeregisterChain(chainID ids.ID, onRequest func())
 	// Shutdown this server
 	Shutdown() error
 }
@@ -92,6 +99,11 @@ type server struct {
 	// Maps endpoints to handlers
 	router *router
 
+	chainRoutesLock sync.Mutex
+	// Maps a chain's ID to the handlers of its endpoints. The handlers are
+	// replaced if the chain is re-created.
+	chainRoutes map[ids.ID]map[string]*utils.Atomic[http.Handler]
+
 	srv *http.Server
 
 	// Listener used to serve traffic
@@ -159,6 +171,7 @@ func New(
 		tracer:          tracer,
 		metrics:         m,
 		router:          router,
+		chainRoutes:     make(map[ids.ID]map[string]*utils.Atomic[http.Handler]),
 		srv:             httpServer,
 		listener:        listener,
 	}, nil
@@ -172,6 +185,23 @@ func (s *server) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 	ctx.Lock.Lock()
 	handlers, err := vm.CreateHandlers(context.TODO())
 	ctx.Lock.Unlock()
+
+	s.chainRoutesLock.Lock()
+	defer s.chainRoutesLock.Unlock()
+
+	routes, ok := s.chainRoutes[ctx.ChainID]
+	if !ok {
+		routes = make(map[string]*utils.Atomic[http.Handler])
+		s.chainRoutes[ctx.ChainID] = routes
+	}
+	// If the chain was re-created, the endpoints that it no longer serves
+	// must not reach the VM of its previous instance.
+	for extension, route := range routes {
+		if _, ok := handlers[extension]; !ok || err != nil {
+			route.Set(http.NotFoundHandler())
+		}
+	}
+
 	if err != nil {
 		s.log.Error("failed to create handlers",
 			zap.String("chainName", chainName),
@@ -198,26 +228,59 @@ func (s *server) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 			)
 			continue
 		}
-		if err := s.addChainRoute(chainName, handler, ctx, defaultEndpoint, extension); err != nil {
+		handler = s.wrapChainHandler(chainName, handler, ctx)
+		if route, ok := routes[extension]; ok {
+			route.Set(handler)
+			continue
+		}
+
+		route := &utils.Atomic[http.Handler]{}
+		route.Set(handler)
+		if err := s.addChainRoute(route, defaultEndpoint, extension); err != nil {
 			s.log.Error("error adding route",
 				zap.Error(err),
 			)
+			continue
 		}
+		routes[extension] = route
 	}
 }
 
-func (s *server) addChainRoute(chainName string, handler http.Handler, ctx *snow.ConsensusContext, base, endpoint string) error {
-	url := fmt.Sprintf("%s/%s", baseURL, base)
-	s.log.Info("adding route",
-		zap.String("url", url),
-		zap.String("endpoint", endpoint),
-	)
+func (s *server) DeregisterChain(chainID ids.ID, onRequest func()) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if onRequest != nil {
+			onRequest()
+		}
+		http.Error(w, "API call rejected because chain is not running", http.StatusServiceUnavailable)
+	})
+
+	s.chainRoutesLock.Lock()
+	defer s.chainRoutesLock.Unlock()
+
+	for _, route := range s.chainRoutes[chainID] {
+		route.Set(handler)
+	}
+}
+
+func (s *server) wrapChainHandler(chainName string, handler http.Handler, ctx *snow.ConsensusContext) http.Handler {
 	if s.tracingEnabled {
 		handler = api.TraceHandler(handler, chainName, s.tracer)
 	}
 	// Apply middleware to reject calls to the handler before the chain finishes bootstrapping
 	handler = rejectMiddleware(handler, ctx)
-	handler = s.metrics.wrapHandler(chainName, handler)
+	return s.metrics.wrapHandler(chainName, handler)
+}
+
+// addChainRoute routes the endpoint to the handler currently held by [route].
+func (s *server) addChainRoute(route *utils.Atomic[http.Handler], base, endpoint string) error {
+	url := fmt.Sprintf("%s/%s", baseURL, base)
+	s.log.Info("adding route",
+		zap.String("url", url),
+		zap.String("endpoint", endpoint),
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route.Get().ServeHTTP(w, r)
+	})
 	return s.router.AddRouter(url, endpoint, handler)
 }
 
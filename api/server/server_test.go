@@ -4,14 +4,20 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
 )
 
 func TestRejectMiddleware(t *testing.T) {
@@ -72,3 +78,102 @@ func TestRejectMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterChainReplacesHandlers(t *testing.T) {
+	require := require.New(t)
+
+	m, err := newMetrics("", prometheus.NewRegistry())
+	require.NoError(err)
+	s := &server{
+		log:         logging.NoLog{},
+		metrics:     m,
+		router:      newRouter(),
+		chainRoutes: make(map[ids.ID]map[string]*utils.Atomic[http.Handler]),
+	}
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	ctx.State.Set(snow.EngineState{
+		State: snow.NormalOp,
+	})
+
+	statusHandler := func(code int) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(code)
+		})
+	}
+	serve := func(extension string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/ext/bc/"+ctx.ChainID.String()+extension, nil)
+		s.router.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	s.RegisterChain("C", ctx, &common.TestVM{
+		CreateHandlersF: func(context.Context) (map[string]http.Handler, error) {
+			return map[string]http.Handler{
+				"/rpc": statusHandler(http.StatusTeapot),
+				"/ws":  statusHandler(http.StatusTeapot),
+			}, nil
+		},
+	})
+	require.Equal(http.StatusTeapot, serve("/rpc"))
+	require.Equal(http.StatusTeapot, serve("/ws"))
+
+	// Re-creating the chain must route its endpoints to the new VM.
+	s.RegisterChain("C", ctx, &common.TestVM{
+		CreateHandlersF: func(context.Context) (map[string]http.Handler, error) {
+			return map[string]http.Handler{
+				"/rpc": statusHandler(http.StatusAccepted),
+			}, nil
+		},
+	})
+	require.Equal(http.StatusAccepted, serve("/rpc"))
+	require.Equal(http.StatusNotFound, serve("/ws"))
+}
+
+func TestDeregisterChainRejectsCalls(t *testing.T) {
+	require := require.New(t)
+
+	m, err := newMetrics("", prometheus.NewRegistry())
+	require.NoError(err)
+	s := &server{
+		log:         logging.NoLog{},
+		metrics:     m,
+		router:      newRouter(),
+		chainRoutes: make(map[ids.ID]map[string]*utils.Atomic[http.Handler]),
+	}
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	ctx.State.Set(snow.EngineState{
+		State: snow.NormalOp,
+	})
+
+	serve := func() int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/ext/bc/"+ctx.ChainID.String()+"/rpc", nil)
+		s.router.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	s.RegisterChain("C", ctx, &common.TestVM{
+		CreateHandlersF: func(context.Context) (map[string]http.Handler, error) {
+			return map[string]http.Handler{
+				"/rpc": http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusTeapot)
+				}),
+			}, nil
+		},
+	})
+	require.Equal(http.StatusTeapot, serve())
+
+	// The VM of a chain that was shut down must not be called, even though
+	// the chain's context still reports normal operations.
+	var numRequests int
+	s.DeregisterChain(ctx.ChainID, func() {
+		numRequests++
+	})
+	require.Equal(http.StatusServiceUnavailable, serve())
+	require.Equal(1, numRequests)
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/subnets"
+)
+
+const chainLabel = "chain"
+
+var _ subnets.Subnet = (*bootstrapTimer)(nil)
+
+func newBootstrapDurationMetric(registerer prometheus.Registerer) (*prometheus.GaugeVec, error) {
+	bootstrapDuration := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bootstrap_duration",
+			Help: "Time (in ns) from the creation of a chain until it finished bootstrapping",
+		},
+		[]string{chainLabel},
+	)
+	return bootstrapDuration, registerer.Register(bootstrapDuration)
+}
+
+// bootstrapTimer reports how long a chain took to bootstrap when the chain's
+// bootstrapper marks it as bootstrapped in its subnet.
+type bootstrapTimer struct {
+	subnets.Subnet
+
+	chainID           ids.ID
+	start             time.Time
+	bootstrapDuration *prometheus.GaugeVec
+
+	// A chain may bootstrap again after falling behind, only the first
+	// bootstrap is reported.
+	once sync.Once
+}
+
+func newBootstrapTimer(
+	sb subnets.Subnet,
+	chainID ids.ID,
+	start time.Time,
+	bootstrapDuration *prometheus.GaugeVec,
+) *bootstrapTimer {
+	return &bootstrapTimer{
+		Subnet:            sb,
+		chainID:           chainID,
+		start:             start,
+		bootstrapDuration: bootstrapDuration,
+	}
+}
+
+func (b *bootstrapTimer) Bootstrapped(chainID ids.ID) {
+	if chainID == b.chainID {
+		b.once.Do(func() {
+			b.bootstrapDuration.WithLabelValues(chainID.String()).Set(float64(time.Since(b.start)))
+		})
+	}
+	b.Subnet.Bootstrapped(chainID)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/subnets"
+)
+
+func TestBootstrapTimer(t *testing.T) {
+	require := require.New(t)
+
+	bootstrapDuration, err := newBootstrapDurationMetric(prometheus.NewRegistry())
+	require.NoError(err)
+
+	chainID := ids.GenerateTestID()
+	sb := subnets.New(ids.EmptyNodeID, subnets.Config{})
+	require.True(sb.AddChain(chainID))
+
+	start := time.Now().Add(-time.Minute)
+	timer := newBootstrapTimer(sb, chainID, start, bootstrapDuration)
+	require.False(timer.IsBootstrapped())
+
+	timer.Bootstrapped(chainID)
+	require.True(sb.IsBootstrapped())
+
+	gauge := bootstrapDuration.WithLabelValues(chainID.String())
+	duration := testutil.ToFloat64(gauge)
+	require.GreaterOrEqual(duration, float64(time.Minute))
+
+	// Bootstrapping the chain again doesn't update the reported duration.
+	timer.Bootstrapped(chainID)
+	require.Equal(duration, testutil.ToFloat64(gauge))
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/heap"
+)
+
+type queuedChain struct {
+	params ChainParameters
+	// Chains with a lower priority are created first.
+	priority int
+	// Chains with the same priority are created in the order they were queued.
+	sequence uint64
+}
+
+func (c queuedChain) less(other queuedChain) bool {
+	if c.priority != other.priority {
+		return c.priority < other.priority
+	}
+	return c.sequence < other.sequence
+}
+
+// chainQueue holds the chains waiting to be created. The chains of the subnets
+// in the creation order are popped first, in that order. The chains of all
+// other subnets are popped after them, in the order they were pushed.
+type chainQueue struct {
+	// subnetID -> position of the subnet in the creation order
+	subnetPriorities map[ids.ID]int
+
+	lock         sync.Mutex
+	cond         *sync.Cond
+	closed       bool
+	nextSequence uint64
+	chains       heap.Queue[queuedChain]
+}
+
+func newChainQueue(subnetOrder []ids.ID) *chainQueue {
+	q := &chainQueue{
+		subnetPriorities: make(map[ids.ID]int, len(subnetOrder)),
+		chains:           heap.NewQueue(queuedChain.less),
+	}
+	for i, subnetID := range subnetOrder {
+		if _, ok := q.subnetPriorities[subnetID]; !ok {
+			q.subnetPriorities[subnetID] = i
+		}
+	}
+	q.cond = sync.NewCond(&q.lock)
+	return q
+}
+
+// Push queues [chainParams] to be created. Returns false if the queue is
+// closed.
+func (q *chainQueue) Push(chainParams ChainParameters) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	priority, ok := q.subnetPriorities[chainParams.SubnetID]
+	if !ok {
+		priority = len(q.subnetPriorities)
+	}
+	q.chains.Push(queuedChain{
+		params:   chainParams,
+		priority: priority,
+		sequence: q.nextSequence,
+	})
+	q.nextSequence++
+	q.cond.Signal()
+	return true
+}
+
+// Pop blocks until a chain is queued and returns the chain that should be
+// created next. Returns false if the queue is closed.
+func (q *chainQueue) Pop() (ChainParameters, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for !q.closed && q.chains.Len() == 0 {
+		q.cond.Wait()
+	}
+	if q.closed {
+		return ChainParameters{}, false
+	}
+
+	chain, _ := q.chains.Pop()
+	return chain.params, true
+}
+
+func (q *chainQueue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return q.chains.Len()
+}
+
+// Close empties the queue and unblocks any pending Pop.
+func (q *chainQueue) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.closed = true
+	q.chains = heap.NewQueue(queuedChain.less)
+	q.cond.Broadcast()
+}
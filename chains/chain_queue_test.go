@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+func TestChainQueueOrder(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		subnetID1 = ids.GenerateTestID()
+		subnetID2 = ids.GenerateTestID()
+		subnetID3 = ids.GenerateTestID()
+
+		unlistedChain  = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID3}
+		subnet2Chain   = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID2}
+		xChain         = ChainParameters{ID: ids.GenerateTestID(), SubnetID: constants.PrimaryNetworkID}
+		subnet1Chain   = ChainParameters{ID: ids.GenerateTestID(), SubnetID: subnetID1}
+		cChain         = ChainParameters{ID: ids.GenerateTestID(), SubnetID: constants.PrimaryNetworkID}
+		unlistedChain2 = ChainParameters{ID: ids.GenerateTestID(), SubnetID: ids.GenerateTestID()}
+	)
+
+	q := newChainQueue([]ids.ID{constants.PrimaryNetworkID, subnetID1, subnetID2})
+	for _, chainParams := range []ChainParameters{
+		unlistedChain,
+		subnet2Chain,
+		xChain,
+		subnet1Chain,
+		cChain,
+		unlistedChain2,
+	} {
+		require.True(q.Push(chainParams))
+	}
+	require.Equal(6, q.Len())
+
+	for _, expected := range []ChainParameters{
+		xChain,
+		cChain,
+		subnet1Chain,
+		subnet2Chain,
+		unlistedChain,
+		unlistedChain2,
+	} {
+		chainParams, ok := q.Pop()
+		require.True(ok)
+		require.Equal(expected.ID, chainParams.ID)
+	}
+	require.Zero(q.Len())
+}
+
+func TestChainQueueClose(t *testing.T) {
+	require := require.New(t)
+
+	q := newChainQueue(nil)
+	require.True(q.Push(ChainParameters{ID: ids.GenerateTestID()}))
+	_, ok := q.Pop()
+	require.True(ok)
+
+	// Pop blocks on the empty queue until it is closed.
+	popped := make(chan bool)
+	go func() {
+		_, ok := q.Pop()
+		popped <- ok
+	}()
+
+	q.Close()
+	require.False(<-popped)
+	require.False(q.Push(ChainParameters{ID: ids.GenerateTestID()}))
+	require.Zero(q.Len())
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+const idleTrackerAcceptorName = "idle tracker"
+
+var (
+	_ snow.Acceptor                  = (*idleTracker)(nil)
+	_ validators.SetCallbackListener = (*subnetValidatorListener)(nil)
+)
+
+// idleTracker tracks when each chain last accepted a container, so that chains
+// without recent activity can be shut down.
+type idleTracker struct {
+	clock mockable.Clock
+
+	lock sync.Mutex
+	// chainID -> last time the chain was created or accepted a container
+	lastActive map[ids.ID]time.Time
+}
+
+func newIdleTracker() *idleTracker {
+	return &idleTracker{
+		lastActive: make(map[ids.ID]time.Time),
+	}
+}
+
+// Track starts tracking the activity of [chainID]. The chain is considered to
+// be active at the time it is tracked.
+func (t *idleTracker) Track(chainID ids.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.lastActive[chainID] = t.clock.Time()
+}
+
+// Remove stops tracking the activity of [chainID].
+func (t *idleTracker) Remove(chainID ids.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.lastActive, chainID)
+}
+
+func (t *idleTracker) Accept(ctx *snow.ConsensusContext, _ ids.ID, _ []byte) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, ok := t.lastActive[ctx.ChainID]; ok {
+		t.lastActive[ctx.ChainID] = t.clock.Time()
+	}
+	return nil
+}
+
+// Idle returns the tracked chains that haven't been active for at least
+// [timeout].
+func (t *idleTracker) Idle(timeout time.Duration) []ids.ID {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var (
+		now      = t.clock.Time()
+		chainIDs []ids.ID
+	)
+	for chainID, lastActive := range t.lastActive {
+		if now.Sub(lastActive) >= timeout {
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+	return chainIDs
+}
+
+// subnetValidatorListener re-creates the chains of a subnet that were shut down
+// for being idle once this node becomes one of the subnet's validators.
+type subnetValidatorListener struct {
+	m        *manager
+	subnetID ids.ID
+}
+
+func (l *subnetValidatorListener) OnValidatorAdded(nodeID ids.NodeID, _ *bls.PublicKey, _ ids.ID, _ uint64) {
+	if nodeID == l.m.NodeID {
+		l.m.reloadChains(l.subnetID)
+	}
+}
+
+func (*subnetValidatorListener) OnValidatorRemoved(ids.NodeID, uint64) {}
+
+func (*subnetValidatorListener) OnValidatorWeightChanged(ids.NodeID, uint64, uint64) {}
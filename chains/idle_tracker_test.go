@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+)
+
+func TestIdleTracker(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		start   = time.Unix(1_000_000, 0)
+		timeout = time.Hour
+
+		idleChainID    = ids.GenerateTestID()
+		activeChainID  = ids.GenerateTestID()
+		untrackedChain = ids.GenerateTestID()
+	)
+
+	tracker := newIdleTracker()
+	tracker.clock.Set(start)
+	tracker.Track(idleChainID)
+	tracker.Track(activeChainID)
+	require.Empty(tracker.Idle(timeout))
+
+	tracker.clock.Set(start.Add(timeout / 2))
+	require.NoError(tracker.Accept(&snow.ConsensusContext{
+		Context: &snow.Context{ChainID: activeChainID},
+	}, ids.GenerateTestID(), nil))
+	// Accepting on an untracked chain doesn't start tracking it.
+	require.NoError(tracker.Accept(&snow.ConsensusContext{
+		Context: &snow.Context{ChainID: untrackedChain},
+	}, ids.GenerateTestID(), nil))
+
+	tracker.clock.Set(start.Add(timeout))
+	require.Equal([]ids.ID{idleChainID}, tracker.Idle(timeout))
+
+	tracker.clock.Set(start.Add(timeout * 3 / 2))
+	require.ElementsMatch([]ids.ID{idleChainID, activeChainID}, tracker.Idle(timeout))
+
+	tracker.Remove(idleChainID)
+	require.Equal([]ids.ID{activeChainID}, tracker.Idle(timeout))
+}
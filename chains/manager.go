@@ -15,6 +15,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 
 	"github.com/ava-labs/avalanchego/api/health"
 	"github.com/ava-labs/avalanchego/api/keystore"
@@ -44,13 +45,13 @@ import (
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/trace"
-	"github.com/ava-labs/avalanchego/utils/buffer"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/metric"
 	"github.com/ava-labs/avalanchego/utils/perms"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/worker"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms"
 	"github.com/ava-labs/avalanchego/vms/fx"
@@ -75,7 +76,9 @@ import (
 
 const (
 	defaultChannelSize = 1
-	initialQueueSize   = 3
+
+	// DefaultChainCreationConcurrency creates chains one at a time.
+	DefaultChainCreationConcurrency = 1
 )
 
 var (
@@ -91,6 +94,7 @@ var (
 	// Bootstrapping prefixes for ChainVMs
 	ChainBootstrappingDBPrefix = []byte("interval_bs")
 
+	errUnknownVM               = errors.New("unknown VM")
 	errUnknownVMType           = errors.New("the vm should have type avalanche.DAGVM or snowman.ChainVM")
 	errCreatePlatformVM        = errors.New("attempted to create a chain running the PlatformVM")
 	errNotBootstrapped         = errors.New("subnets not bootstrapped")
@@ -133,6 +137,12 @@ type Manager interface {
 	// Returns true iff the chain with the given ID exists and is finished bootstrapping
 	IsBootstrapped(ids.ID) bool
 
+	// Returns the IDs of the chains that are running
+	LoadedChains() []ids.ID
+
+	// Returns the IDs of the chains that were shut down for being idle
+	UnloadedChains() []ids.ID
+
 	// Starts the chain creator with the initial platform chain parameters, must
 	// be called once.
 	StartChainCreator(platformChain ChainParameters) error
@@ -211,6 +221,14 @@ type ManagerConfig struct {
 
 	FrontierPollFrequency   time.Duration
 	ConsensusAppConcurrency int
+	// Maximum number of chains that are created concurrently
+	ChainCreationConcurrency int
+	// Subnets whose chains are created before the chains of other subnets, in
+	// the order they are created
+	ChainCreationOrder []ids.ID
+	// Subnet chains that haven't accepted a container for this long are shut
+	// down. If 0, chains are never shut down for being idle.
+	ChainIdleShutdownTimeout time.Duration
 
 	// Max Time to spend fetching a container and its
 	// ancestors when responding to a GetAncestors
@@ -220,6 +238,9 @@ type ManagerConfig struct {
 	// This node will only consider the first [AncestorsMaxContainersReceived]
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int
+	// Minimum number of beacons that must be connected before a chain starts
+	// bootstrapping. If a chain has fewer beacons, all of them are required.
+	MinBootstrapPeers int
 
 	ApricotPhase4Time            time.Time
 	ApricotPhase4MinPChainHeight uint64
@@ -231,6 +252,10 @@ type ManagerConfig struct {
 
 	ChainDataDir string
 
+	// If true, privileged APIs, such as the proposervm debug API, are
+	// registered for each chain.
+	AdminAPIEnabled bool
+
 	Subnets *Subnets
 }
 
@@ -244,18 +269,41 @@ type manager struct {
 	registrants []Registrant
 
 	// queue that holds chain create requests
-	chainsQueue buffer.BlockingDeque[ChainParameters]
+	chainsQueue *chainQueue
 	// unblocks chain creator to start processing the queue
 	unblockChainCreatorCh chan struct{}
 	// shutdown the chain creator goroutine if the queue hasn't started to be
 	// processed.
 	chainCreatorShutdownCh chan struct{}
 	chainCreatorExited     sync.WaitGroup
+	// creates the queued chains, at most [ChainCreationConcurrency] at a time
+	chainCreator *worker.Pool
+	// chainID -> time (in ns) from the creation of the chain until it finished
+	// bootstrapping
+	bootstrapDuration *prometheus.GaugeVec
 
 	chainsLock sync.Mutex
 	// Key: Chain's ID
 	// Value: The chain
 	chains map[ids.ID]handler.Handler
+	// chains that were created at least once, whose alias and health check
+	// are kept if they are re-created
+	registeredChains set.Set[ids.ID]
+	// Key: Chain's ID
+	// Value: The chain's log, which is reused if the chain is re-created
+	chainLogs map[ids.ID]logging.Logger
+	// Key: ID of a chain that may be shut down for being idle
+	// Value: The parameters the chain was created with
+	idleChains map[ids.ID]ChainParameters
+	// Key: ID of a chain that was shut down for being idle
+	// Value: The parameters to re-create the chain with
+	unloadedChains map[ids.ID]ChainParameters
+	// subnets whose validator set is watched to re-create their unloaded
+	// chains once this node becomes one of their validators
+	watchedSubnets set.Set[ids.ID]
+
+	// tracks the activity of the chains that may be shut down for being idle
+	idleTracker *idleTracker
 
 	// snowman++ related interface to allow validators retrieval
 	validatorState validators.State
@@ -267,7 +315,11 @@ func New(config *ManagerConfig) Manager {
 		Aliaser:                ids.NewAliaser(),
 		ManagerConfig:          *config,
 		chains:                 make(map[ids.ID]handler.Handler),
-		chainsQueue:            buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
+		chainLogs:              make(map[ids.ID]logging.Logger),
+		idleChains:             make(map[ids.ID]ChainParameters),
+		unloadedChains:         make(map[ids.ID]ChainParameters),
+		chainsQueue:            newChainQueue(config.ChainCreationOrder),
+		idleTracker:            newIdleTracker(),
 		unblockChainCreatorCh:  make(chan struct{}),
 		chainCreatorShutdownCh: make(chan struct{}),
 	}
@@ -276,6 +328,32 @@ func New(config *ManagerConfig) Manager {
 // QueueChainCreation queues a chain creation request
 // Invariant: Tracked Subnet must be checked before calling this function
 func (m *manager) QueueChainCreation(chainParams ChainParameters) {
+	// Verify the VM is known before staging the chain so that the failure is
+	// reported immediately rather than once the chain is being built.
+	if _, err := m.VMManager.GetFactory(chainParams.VMID); err != nil {
+		if m.CriticalChains.Contains(chainParams.ID) {
+			// Shut down if a required chain (i.e. X, P or C) can't be created
+			m.Log.Fatal("error creating required chain",
+				zap.Stringer("subnetID", chainParams.SubnetID),
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Stringer("vmID", chainParams.VMID),
+				zap.Error(err),
+			)
+			go m.ShutdownNodeFunc(1)
+			return
+		}
+
+		m.Log.Warn("skipping chain creation",
+			zap.String("reason", "unknown VM"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.Stringer("vmID", chainParams.VMID),
+			zap.Error(err),
+		)
+		m.registerFailingHealthCheck(chainParams, fmt.Errorf("%w: %w", errUnknownVM, err))
+		return
+	}
+
 	if sb, _ := m.Subnets.GetOrCreate(chainParams.SubnetID); !sb.AddChain(chainParams.ID) {
 		m.Log.Debug("skipping chain creation",
 			zap.String("reason", "chain already staged"),
@@ -286,7 +364,7 @@ func (m *manager) QueueChainCreation(chainParams ChainParameters) {
 		return
 	}
 
-	if ok := m.chainsQueue.PushRight(chainParams); !ok {
+	if ok := m.chainsQueue.Push(chainParams); !ok {
 		m.Log.Warn("skipping chain creation",
 			zap.String("reason", "couldn't enqueue chain"),
 			zap.Stringer("subnetID", chainParams.SubnetID),
@@ -307,6 +385,7 @@ func (m *manager) createChain(chainParams ChainParameters) {
 		zap.Stringer("vmID", chainParams.VMID),
 	)
 
+	start := time.Now()
 	sb, _ := m.Subnets.GetOrCreate(chainParams.SubnetID)
 
 	// Note: buildChain builds all chain's relevant objects (notably engine and handler)
@@ -314,7 +393,7 @@ func (m *manager) createChain(chainParams ChainParameters) {
 	// issue some internal messages), is delayed until chain dispatching is started and
 	// the chain is registered in the manager. This ensures that no message generated by handler
 	// upon start is dropped.
-	chain, err := m.buildChain(chainParams, sb)
+	chain, err := m.buildChain(chainParams, newBootstrapTimer(sb, chainParams.ID, start, m.bootstrapDuration))
 	if err != nil {
 		if m.CriticalChains.Contains(chainParams.ID) {
 			// Shut down if we fail to create a required chain (i.e. X, P or C)
@@ -328,51 +407,34 @@ func (m *manager) createChain(chainParams ChainParameters) {
 			return
 		}
 
-		chainAlias := m.PrimaryAliasOrDefault(chainParams.ID)
 		m.Log.Error("error creating chain",
 			zap.Stringer("subnetID", chainParams.SubnetID),
 			zap.Stringer("chainID", chainParams.ID),
-			zap.String("chainAlias", chainAlias),
+			zap.String("chainAlias", m.PrimaryAliasOrDefault(chainParams.ID)),
 			zap.Stringer("vmID", chainParams.VMID),
 			zap.Error(err),
 		)
-
-		// Register the health check for this chain regardless of if it was
-		// created or not. This attempts to notify the node operator that their
-		// node may not be properly validating the subnet they expect to be
-		// validating.
-		healthCheckErr := fmt.Errorf("failed to create chain on subnet %s: %w", chainParams.SubnetID, err)
-		err := m.Health.RegisterHealthCheck(
-			chainAlias,
-			health.CheckerFunc(func(context.Context) (interface{}, error) {
-				return nil, healthCheckErr
-			}),
-			chainParams.SubnetID.String(),
-		)
-		if err != nil {
-			m.Log.Error("failed to register failing health check",
-				zap.Stringer("subnetID", chainParams.SubnetID),
-				zap.Stringer("chainID", chainParams.ID),
-				zap.String("chainAlias", chainAlias),
-				zap.Stringer("vmID", chainParams.VMID),
-				zap.Error(err),
-			)
-		}
+		m.registerFailingHealthCheck(chainParams, err)
 		return
 	}
 
 	m.chainsLock.Lock()
 	m.chains[chainParams.ID] = chain.Handler
+	recreated := m.registeredChains.Contains(chainParams.ID)
+	m.registeredChains.Add(chainParams.ID)
 	m.chainsLock.Unlock()
 
-	// Associate the newly created chain with its default alias
-	if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
-		m.Log.Error("failed to alias the new chain with itself",
-			zap.Stringer("subnetID", chainParams.SubnetID),
-			zap.Stringer("chainID", chainParams.ID),
-			zap.Stringer("vmID", chainParams.VMID),
-			zap.Error(err),
-		)
+	// Associate the newly created chain with its default alias. A re-created
+	// chain is still aliased from when it was first created.
+	if !recreated {
+		if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
+			m.Log.Error("failed to alias the new chain with itself",
+				zap.Stringer("subnetID", chainParams.SubnetID),
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Stringer("vmID", chainParams.VMID),
+				zap.Error(err),
+			)
+		}
 	}
 
 	// Notify those that registered to be notified when a new chain is created
@@ -395,11 +457,45 @@ func (m *manager) createChain(chainParams ChainParameters) {
 		}
 	}
 
+	if m.ChainIdleShutdownTimeout > 0 && chainParams.SubnetID != constants.PrimaryNetworkID {
+		if err := m.trackIdleChain(chainParams); err != nil {
+			m.Log.Warn("chain won't be shut down when idle",
+				zap.Stringer("subnetID", chainParams.SubnetID),
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
 	// Tell the chain to start processing messages.
 	// If the X, P, or C Chain panics, do not attempt to recover
 	chain.Handler.Start(context.TODO(), !m.CriticalChains.Contains(chainParams.ID))
 }
 
+// registerFailingHealthCheck registers the health check for a chain that
+// couldn't be created. This attempts to notify the node operator that their
+// node may not be properly validating the subnet they expect to be validating.
+func (m *manager) registerFailingHealthCheck(chainParams ChainParameters, err error) {
+	chainAlias := m.PrimaryAliasOrDefault(chainParams.ID)
+	healthCheckErr := fmt.Errorf("failed to create chain on subnet %s: %w", chainParams.SubnetID, err)
+	err = m.Health.RegisterHealthCheck(
+		chainAlias,
+		health.CheckerFunc(func(context.Context) (interface{}, error) {
+			return nil, healthCheckErr
+		}),
+		chainParams.SubnetID.String(),
+	)
+	if err != nil {
+		m.Log.Error("failed to register failing health check",
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.String("chainAlias", chainAlias),
+			zap.Stringer("vmID", chainParams.VMID),
+			zap.Error(err),
+		)
+	}
+}
+
 // Create a chain
 func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*chain, error) {
 	if chainParams.ID != constants.PlatformChainID && chainParams.VMID == constants.PlatformVMID {
@@ -414,13 +510,19 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 	}
 
 	// Create the log and context of the chain
-	chainLog, err := m.LogFactory.MakeChain(primaryAlias)
+	chainLog, err := m.chainLog(chainParams.ID, primaryAlias)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating chain's log %w", err)
 	}
+	// Tag every log line emitted by this chain, including by its VM, so that
+	// the logs of a multi-chain node can be filtered by subnet and chain.
+	chainLog = chainLog.With(
+		zap.Stringer("subnetID", chainParams.SubnetID),
+		zap.Stringer("chainID", chainParams.ID),
+	)
 
 	consensusMetrics := prometheus.NewRegistry()
-	chainNamespace := metric.AppendNamespace(constants.PlatformName, primaryAlias)
+	chainNamespace := m.chainNamespace(chainParams.ID)
 	if err := m.Metrics.Register(chainNamespace, consensusMetrics); err != nil {
 		return nil, fmt.Errorf("error while registering chain's metrics %w", err)
 	}
@@ -540,6 +642,28 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 	return chain, nil
 }
 
+// chainLog returns the log of the chain. A chain that is re-created reuses the
+// log of its first instance.
+func (m *manager) chainLog(chainID ids.ID, primaryAlias string) (logging.Logger, error) {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	if chainLog, ok := m.chainLogs[chainID]; ok {
+		return chainLog, nil
+	}
+	chainLog, err := m.LogFactory.MakeChain(primaryAlias)
+	if err != nil {
+		return nil, err
+	}
+	m.chainLogs[chainID] = chainLog
+	return chainLog, nil
+}
+
+// chainNamespace returns the namespace of the chain's metrics.
+func (m *manager) chainNamespace(chainID ids.ID) string {
+	return metric.AppendNamespace(constants.PlatformName, m.PrimaryAliasOrDefault(chainID))
+}
+
 func (m *manager) AddRegistrant(r Registrant) {
 	m.registrants = append(m.registrants, r)
 }
@@ -679,16 +803,30 @@ func (m *manager) createAvalancheChain(
 	// Initialize the ProposerVM and the vm wrapped inside it
 	var (
 		minBlockDelay       = proposervm.DefaultMinBlockDelay
+		freeForAllDelay     = proposervm.DefaultFreeForAllDelay
+		buildJitter         = proposervm.DefaultBuildJitter
+		buildBlockRetries   = proposervm.DefaultBuildBlockRetries
+		buildBlockBackoff   = proposervm.DefaultBuildBlockRetryBackoff
 		numHistoricalBlocks = proposervm.DefaultNumHistoricalBlocks
+		activationWarnings  = proposervm.DefaultActivationWarnings
 	)
 	if subnetCfg, ok := m.SubnetConfigs[ctx.SubnetID]; ok {
 		minBlockDelay = subnetCfg.ProposerMinBlockDelay
+		freeForAllDelay = subnetCfg.ProposerFreeForAllDelay
+		buildJitter = subnetCfg.ProposerBuildJitter
+		buildBlockRetries = subnetCfg.ProposerBuildBlockRetries
+		buildBlockBackoff = subnetCfg.ProposerBuildBlockRetryBackoff
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
+		activationWarnings = subnetCfg.ProposerActivationWarnings
 	}
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.ApricotPhase4MinPChainHeight),
 		zap.Duration("minBlockDelay", minBlockDelay),
+		zap.Duration("freeForAllDelay", freeForAllDelay),
+		zap.Duration("buildJitter", buildJitter),
+		zap.Int("buildBlockRetries", buildBlockRetries),
+		zap.Duration("buildBlockRetryBackoff", buildBlockBackoff),
 		zap.Uint64("numHistoricalBlocks", numHistoricalBlocks),
 	)
 
@@ -707,13 +845,19 @@ func (m *manager) createAvalancheChain(
 	var vmWrappingProposerVM block.ChainVM = proposervm.New(
 		vmWrappedInsideProposerVM,
 		proposervm.Config{
-			ActivationTime:      m.ApricotPhase4Time,
-			DurangoTime:         version.GetDurangoTime(m.NetworkID),
-			MinimumPChainHeight: m.ApricotPhase4MinPChainHeight,
-			MinBlkDelay:         minBlockDelay,
-			NumHistoricalBlocks: numHistoricalBlocks,
-			StakingLeafSigner:   m.StakingTLSSigner,
-			StakingCertLeaf:     m.StakingTLSCert,
+			ActivationTime:         m.ApricotPhase4Time,
+			DurangoTime:            version.GetDurangoTime(m.NetworkID),
+			MinimumPChainHeight:    m.ApricotPhase4MinPChainHeight,
+			MinBlkDelay:            minBlockDelay,
+			FreeForAllDelay:        freeForAllDelay,
+			BuildJitter:            buildJitter,
+			BuildBlockRetries:      buildBlockRetries,
+			BuildBlockRetryBackoff: buildBlockBackoff,
+			NumHistoricalBlocks:    numHistoricalBlocks,
+			DebugAPIEnabled:        m.AdminAPIEnabled,
+			ActivationWarnings:     activationWarnings,
+			StakingLeafSigner:      m.StakingTLSSigner,
+			StakingCertLeaf:        m.StakingTLSCert,
 		},
 	)
 
@@ -787,8 +931,15 @@ func (m *manager) createAvalancheChain(
 		return nil, fmt.Errorf("error initializing network handler: %w", err)
 	}
 
-	connectedBeacons := tracker.NewPeers()
-	startupTracker := tracker.NewStartup(connectedBeacons, (3*bootstrapWeight+3)/4)
+	connectedBeacons, err := tracker.NewMeteredPeers("beacons", ctx.Registerer)
+	if err != nil {
+		return nil, fmt.Errorf("error creating beacon tracker: %w", err)
+	}
+	startupTracker := tracker.NewStartup(
+		connectedBeacons,
+		(3*bootstrapWeight+3)/4,
+		min(m.MinBootstrapPeers, vdrs.Count(ctx.SubnetID)),
+	)
 	vdrs.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 
 	snowGetHandler, err := snowgetter.New(
@@ -919,8 +1070,8 @@ func (m *manager) createAvalancheChain(
 	})
 
 	// Register health check for this chain
-	if err := m.Health.RegisterHealthCheck(chainAlias, h, ctx.SubnetID.String()); err != nil {
-		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", chainAlias, err)
+	if err := m.registerChainHealthCheck(chainAlias, ctx, h); err != nil {
+		return nil, err
 	}
 
 	return &chain{
@@ -1031,16 +1182,30 @@ func (m *manager) createSnowmanChain(
 
 	var (
 		minBlockDelay       = proposervm.DefaultMinBlockDelay
+		freeForAllDelay     = proposervm.DefaultFreeForAllDelay
+		buildJitter         = proposervm.DefaultBuildJitter
+		buildBlockRetries   = proposervm.DefaultBuildBlockRetries
+		buildBlockBackoff   = proposervm.DefaultBuildBlockRetryBackoff
 		numHistoricalBlocks = proposervm.DefaultNumHistoricalBlocks
+		activationWarnings  = proposervm.DefaultActivationWarnings
 	)
 	if subnetCfg, ok := m.SubnetConfigs[ctx.SubnetID]; ok {
 		minBlockDelay = subnetCfg.ProposerMinBlockDelay
+		freeForAllDelay = subnetCfg.ProposerFreeForAllDelay
+		buildJitter = subnetCfg.ProposerBuildJitter
+		buildBlockRetries = subnetCfg.ProposerBuildBlockRetries
+		buildBlockBackoff = subnetCfg.ProposerBuildBlockRetryBackoff
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
+		activationWarnings = subnetCfg.ProposerActivationWarnings
 	}
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.ApricotPhase4MinPChainHeight),
 		zap.Duration("minBlockDelay", minBlockDelay),
+		zap.Duration("freeForAllDelay", freeForAllDelay),
+		zap.Duration("buildJitter", buildJitter),
+		zap.Int("buildBlockRetries", buildBlockRetries),
+		zap.Duration("buildBlockRetryBackoff", buildBlockBackoff),
 		zap.Uint64("numHistoricalBlocks", numHistoricalBlocks),
 	)
 
@@ -1052,13 +1217,19 @@ func (m *manager) createSnowmanChain(
 	vm = proposervm.New(
 		vm,
 		proposervm.Config{
-			ActivationTime:      m.ApricotPhase4Time,
-			DurangoTime:         version.GetDurangoTime(m.NetworkID),
-			MinimumPChainHeight: m.ApricotPhase4MinPChainHeight,
-			MinBlkDelay:         minBlockDelay,
-			NumHistoricalBlocks: numHistoricalBlocks,
-			StakingLeafSigner:   m.StakingTLSSigner,
-			StakingCertLeaf:     m.StakingTLSCert,
+			ActivationTime:         m.ApricotPhase4Time,
+			DurangoTime:            version.GetDurangoTime(m.NetworkID),
+			MinimumPChainHeight:    m.ApricotPhase4MinPChainHeight,
+			MinBlkDelay:            minBlockDelay,
+			FreeForAllDelay:        freeForAllDelay,
+			BuildJitter:            buildJitter,
+			BuildBlockRetries:      buildBlockRetries,
+			BuildBlockRetryBackoff: buildBlockBackoff,
+			NumHistoricalBlocks:    numHistoricalBlocks,
+			DebugAPIEnabled:        m.AdminAPIEnabled,
+			ActivationWarnings:     activationWarnings,
+			StakingLeafSigner:      m.StakingTLSSigner,
+			StakingCertLeaf:        m.StakingTLSCert,
 		},
 	)
 
@@ -1132,8 +1303,15 @@ func (m *manager) createSnowmanChain(
 		return nil, fmt.Errorf("couldn't initialize message handler: %w", err)
 	}
 
-	connectedBeacons := tracker.NewPeers()
-	startupTracker := tracker.NewStartup(connectedBeacons, (3*bootstrapWeight+3)/4)
+	connectedBeacons, err := tracker.NewMeteredPeers("beacons", ctx.Registerer)
+	if err != nil {
+		return nil, fmt.Errorf("error creating beacon tracker: %w", err)
+	}
+	startupTracker := tracker.NewStartup(
+		connectedBeacons,
+		(3*bootstrapWeight+3)/4,
+		min(m.MinBootstrapPeers, beacons.Count(ctx.SubnetID)),
+	)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 
 	snowGetHandler, err := snowgetter.New(
@@ -1239,8 +1417,8 @@ func (m *manager) createSnowmanChain(
 	})
 
 	// Register health checks
-	if err := m.Health.RegisterHealthCheck(chainAlias, h, ctx.SubnetID.String()); err != nil {
-		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", chainAlias, err)
+	if err := m.registerChainHealthCheck(chainAlias, ctx, h); err != nil {
+		return nil, err
 	}
 
 	return &chain{
@@ -1262,6 +1440,250 @@ func (m *manager) IsBootstrapped(id ids.ID) bool {
 	return chain.Context().State.Get().State == snow.NormalOp
 }
 
+func (m *manager) LoadedChains() []ids.ID {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	return maps.Keys(m.chains)
+}
+
+func (m *manager) UnloadedChains() []ids.ID {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	return maps.Keys(m.unloadedChains)
+}
+
+// registerChainHealthCheck registers the health check of the chain run by [h].
+// A chain that is re-created keeps the health check of its first instance.
+func (m *manager) registerChainHealthCheck(chainAlias string, ctx *snow.ConsensusContext, h handler.Handler) error {
+	m.chainsLock.Lock()
+	recreated := m.registeredChains.Contains(ctx.ChainID)
+	m.chainsLock.Unlock()
+	if recreated {
+		return nil
+	}
+
+	if err := m.Health.RegisterHealthCheck(chainAlias, m.chainHealthCheck(ctx.ChainID, h), ctx.SubnetID.String()); err != nil {
+		return fmt.Errorf("couldn't add health check for chain %s: %w", chainAlias, err)
+	}
+	return nil
+}
+
+// chainHealthCheck reports the health of the running instance of the chain,
+// which is initially run by [h], or that the chain was shut down for being
+// idle.
+func (m *manager) chainHealthCheck(chainID ids.ID, h handler.Handler) health.Checker {
+	return health.CheckerFunc(func(ctx context.Context) (interface{}, error) {
+		m.chainsLock.Lock()
+		_, unloaded := m.unloadedChains[chainID]
+		chain, loaded := m.chains[chainID]
+		m.chainsLock.Unlock()
+
+		switch {
+		case unloaded:
+			return "chain was shut down for being idle", nil
+		case loaded:
+			return chain.HealthCheck(ctx)
+		default:
+			return h.HealthCheck(ctx)
+		}
+	})
+}
+
+func (m *manager) acceptorGroups() []snow.AcceptorGroup {
+	return []snow.AcceptorGroup{
+		m.BlockAcceptorGroup,
+		m.TxAcceptorGroup,
+		m.VertexAcceptorGroup,
+	}
+}
+
+// trackIdleChain starts tracking the containers accepted by the chain so that
+// it can be shut down once it is idle.
+func (m *manager) trackIdleChain(chainParams ChainParameters) error {
+	chainID := chainParams.ID
+	m.idleTracker.Track(chainID)
+	for _, acceptorGroup := range m.acceptorGroups() {
+		err := acceptorGroup.RegisterAcceptor(chainID, idleTrackerAcceptorName, m.idleTracker, false)
+		if err != nil {
+			m.untrackIdleChain(chainID)
+			return err
+		}
+	}
+
+	m.chainsLock.Lock()
+	m.idleChains[chainID] = chainParams
+	watched := m.watchedSubnets.Contains(chainParams.SubnetID)
+	m.watchedSubnets.Add(chainParams.SubnetID)
+	m.chainsLock.Unlock()
+
+	if !watched {
+		m.Validators.RegisterSetCallbackListener(chainParams.SubnetID, &subnetValidatorListener{
+			m:        m,
+			subnetID: chainParams.SubnetID,
+		})
+	}
+	return nil
+}
+
+func (m *manager) untrackIdleChain(chainID ids.ID) {
+	m.idleTracker.Remove(chainID)
+	for _, acceptorGroup := range m.acceptorGroups() {
+		// The acceptor isn't registered in every group if tracking the chain
+		// failed, so the error is ignored.
+		_ = acceptorGroup.DeregisterAcceptor(chainID, idleTrackerAcceptorName)
+	}
+}
+
+// shutdownIdleChains periodically shuts down the chains that haven't accepted
+// a container for [ChainIdleShutdownTimeout].
+func (m *manager) shutdownIdleChains() {
+	defer m.chainCreatorExited.Done()
+
+	// Waiting for an idle chain to stop is abandoned once the manager is shut
+	// down.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-m.chainCreatorShutdownCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(m.ChainIdleShutdownTimeout)
+	defer ticker.Stop()
+
+	for {
+		select {
+		// This channel will be closed when Shutdown is called on the manager.
+		case <-m.chainCreatorShutdownCh:
+			return
+		case <-ticker.C:
+		}
+
+		for _, chainID := range m.idleTracker.Idle(m.ChainIdleShutdownTimeout) {
+			m.shutdownIdleChain(ctx, chainID)
+		}
+	}
+}
+
+func (m *manager) shutdownIdleChain(ctx context.Context, chainID ids.ID) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[chainID]
+	chainParams := m.idleChains[chainID]
+	m.chainsLock.Unlock()
+	if !exists {
+		return
+	}
+
+	// A chain that is still bootstrapping isn't idle, it is catching up to
+	// the network.
+	if chain.Context().State.Get().State != snow.NormalOp {
+		return
+	}
+	// The chains of the subnets this node validates must keep running.
+	if m.isValidator(chainParams.SubnetID) {
+		return
+	}
+
+	m.chainsLock.Lock()
+	delete(m.chains, chainID)
+	delete(m.idleChains, chainID)
+	m.chainsLock.Unlock()
+
+	m.untrackIdleChain(chainID)
+
+	m.Log.Info("shutting down idle chain",
+		zap.Stringer("subnetID", chainParams.SubnetID),
+		zap.Stringer("chainID", chainID),
+		zap.Duration("idleTimeout", m.ChainIdleShutdownTimeout),
+	)
+	// API calls must not reach the VM once it is shut down. An API call made
+	// after the chain was shut down re-creates it.
+	m.Server.DeregisterChain(chainID, func() {
+		m.reloadChain(chainID, "received an API call")
+	})
+	// Stopping the handler removes the chain from the router.
+	chain.Stop(ctx)
+	// The chain can only be re-created once its VM no longer uses the chain's
+	// database.
+	if _, err := chain.AwaitStopped(ctx); err != nil {
+		m.Log.Warn("idle chain won't be re-created",
+			zap.String("reason", "chain didn't shut down"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	chainNamespace := m.chainNamespace(chainID)
+	m.Metrics.Deregister(chainNamespace)
+	m.Metrics.Deregister(metric.AppendNamespace(chainNamespace, "avalanche"))
+	m.Metrics.Deregister(metric.AppendNamespace(chainNamespace, "vm"))
+
+	m.chainsLock.Lock()
+	m.unloadedChains[chainID] = chainParams
+	m.chainsLock.Unlock()
+
+	// This node may have become a validator of the subnet while the chain was
+	// shutting down.
+	if m.isValidator(chainParams.SubnetID) {
+		m.reloadChains(chainParams.SubnetID)
+	}
+}
+
+func (m *manager) isValidator(subnetID ids.ID) bool {
+	_, ok := m.Validators.GetValidator(subnetID, m.NodeID)
+	return ok
+}
+
+// reloadChains queues the re-creation of the chains of [subnetID] that were
+// shut down for being idle.
+func (m *manager) reloadChains(subnetID ids.ID) {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	for _, chainParams := range m.unloadedChains {
+		if chainParams.SubnetID == subnetID {
+			m.queueUnloadedChain(chainParams, "node became a validator of the subnet")
+		}
+	}
+}
+
+// reloadChain queues the re-creation of [chainID] if it was shut down for
+// being idle.
+func (m *manager) reloadChain(chainID ids.ID, reason string) {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	if chainParams, ok := m.unloadedChains[chainID]; ok {
+		m.queueUnloadedChain(chainParams, reason)
+	}
+}
+
+// Assumes [m.chainsLock] is held.
+func (m *manager) queueUnloadedChain(chainParams ChainParameters, reason string) {
+	delete(m.unloadedChains, chainParams.ID)
+	m.Log.Info("re-creating idle chain",
+		zap.String("reason", reason),
+		zap.Stringer("subnetID", chainParams.SubnetID),
+		zap.Stringer("chainID", chainParams.ID),
+	)
+	// The subnet already tracks the chain, so it is queued directly.
+	if ok := m.chainsQueue.Push(chainParams); !ok {
+		m.Log.Warn("skipping chain creation",
+			zap.String("reason", "couldn't enqueue chain"),
+			zap.Stringer("subnetID", chainParams.SubnetID),
+			zap.Stringer("chainID", chainParams.ID),
+			zap.Stringer("vmID", chainParams.VMID),
+		)
+	}
+}
+
 func (m *manager) registerBootstrappedHealthChecks() error {
 	bootstrappedCheck := health.CheckerFunc(func(context.Context) (interface{}, error) {
 		if subnetIDs := m.Subnets.Bootstrapping(); len(subnetIDs) != 0 {
@@ -1306,6 +1728,13 @@ func (m *manager) registerBootstrappedHealthChecks() error {
 
 // Starts chain creation loop to process queued chains
 func (m *manager) StartChainCreator(platformParams ChainParameters) error {
+	chainCreatorMetrics := prometheus.NewRegistry()
+	bootstrapDuration, err := newBootstrapDurationMetric(chainCreatorMetrics)
+	if err != nil {
+		return fmt.Errorf("couldn't create bootstrap duration metric: %w", err)
+	}
+	m.bootstrapDuration = bootstrapDuration
+
 	// Add the P-Chain to the Primary Network
 	sb, _ := m.Subnets.GetOrCreate(constants.PrimaryNetworkID)
 	sb.AddChain(platformParams.ID)
@@ -1316,14 +1745,34 @@ func (m *manager) StartChainCreator(platformParams ChainParameters) error {
 	// depends on.
 	m.createChain(platformParams)
 
-	m.Log.Info("starting chain creator")
+	chainCreator, err := worker.New("", m.ChainCreationConcurrency, 0, chainCreatorMetrics)
+	if err != nil {
+		return fmt.Errorf("couldn't create chain creator: %w", err)
+	}
+	chainCreatorNamespace := metric.AppendNamespace(constants.PlatformName, "chain_creator")
+	if err := m.Metrics.Register(chainCreatorNamespace, chainCreatorMetrics); err != nil {
+		chainCreator.Close()
+		return fmt.Errorf("couldn't register chain creator metrics: %w", err)
+	}
+	m.chainCreator = chainCreator
+
+	m.Log.Info("starting chain creator",
+		zap.Int("concurrency", m.ChainCreationConcurrency),
+	)
 	m.chainCreatorExited.Add(1)
 	go m.dispatchChainCreator()
+
+	if m.ChainIdleShutdownTimeout > 0 {
+		m.chainCreatorExited.Add(1)
+		go m.shutdownIdleChains()
+	}
 	return nil
 }
 
 func (m *manager) dispatchChainCreator() {
 	defer m.chainCreatorExited.Done()
+	// Wait for the chains that are being created before exiting.
+	defer m.chainCreator.Close()
 
 	select {
 	// This channel will be closed when Shutdown is called on the manager.
@@ -1335,13 +1784,28 @@ func (m *manager) dispatchChainCreator() {
 	// Handle chain creations
 	for {
 		// Get the next chain we should create.
-		// Dequeue waits until an element is pushed, so this is not
+		// Pop waits until an element is pushed, so this is not
 		// busy-looping.
-		chainParams, ok := m.chainsQueue.PopLeft()
+		chainParams, ok := m.chainsQueue.Pop()
 		if !ok { // queue is closed, return directly
 			return
 		}
-		m.createChain(chainParams)
+
+		// A failure to create a chain is reported by createChain, so it
+		// doesn't prevent the remaining chains from being created.
+		err := m.chainCreator.Submit(context.TODO(), func() {
+			m.createChain(chainParams)
+		})
+		if err != nil {
+			m.Log.Warn("skipping chain creation",
+				zap.String("reason", "chain creator closed"),
+				zap.Stringer("subnetID", chainParams.SubnetID),
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Stringer("vmID", chainParams.VMID),
+				zap.Error(err),
+			)
+			return
+		}
 	}
 }
 
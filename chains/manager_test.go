@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/api/health"
+	"github.com/ava-labs/avalanchego/api/metrics"
+	"github.com/ava-labs/avalanchego/api/server"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/networking/handler"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms"
+)
+
+var _ health.Registerer = (*testHealthRegisterer)(nil)
+
+type testHealthRegisterer struct {
+	healthChecks map[string]health.Checker
+}
+
+func (*testHealthRegisterer) RegisterReadinessCheck(string, health.Checker, ...string) error {
+	return nil
+}
+
+func (r *testHealthRegisterer) RegisterHealthCheck(name string, checker health.Checker, _ ...string) error {
+	r.healthChecks[name] = checker
+	return nil
+}
+
+func (*testHealthRegisterer) RegisterLivenessCheck(string, health.Checker, ...string) error {
+	return nil
+}
+
+func TestQueueChainCreationUnknownVM(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	s, err := NewSubnets(ids.EmptyNodeID, map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	})
+	require.NoError(err)
+
+	healthRegisterer := &testHealthRegisterer{
+		healthChecks: make(map[string]health.Checker),
+	}
+	m := New(&ManagerConfig{
+		Log:       logging.NoLog{},
+		VMManager: vms.NewManager(logging.NoLog{}, ids.NewAliaser()),
+		Health:    healthRegisterer,
+		Subnets:   s,
+	}).(*manager)
+
+	chainParams := ChainParameters{
+		ID:       ids.GenerateTestID(),
+		SubnetID: subnetID,
+		VMID:     ids.GenerateTestID(),
+	}
+	m.QueueChainCreation(chainParams)
+
+	// The chain should be neither queued nor staged on the subnet.
+	require.Zero(m.chainsQueue.Len())
+	sb, _ := m.Subnets.GetOrCreate(subnetID)
+	require.True(sb.IsBootstrapped())
+
+	checker, ok := healthRegisterer.healthChecks[chainParams.ID.String()]
+	require.True(ok)
+	_, err = checker.HealthCheck(context.Background())
+	require.ErrorIs(err, errUnknownVM)
+	require.ErrorIs(err, vms.ErrNotFound)
+}
+
+func TestIdleChainRecreatedOnceValidator(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	subnetID := ids.GenerateTestID()
+	s, err := NewSubnets(ids.EmptyNodeID, map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	})
+	require.NoError(err)
+
+	apiServer := server.NewMockServer(ctrl)
+	apiServer.EXPECT().DeregisterChain(gomock.Any(), gomock.Any())
+
+	m := New(&ManagerConfig{
+		Log:                      logging.NoLog{},
+		NodeID:                   ids.GenerateTestNodeID(),
+		Validators:               validators.NewManager(),
+		Metrics:                  metrics.NewMultiGatherer(),
+		BlockAcceptorGroup:       snow.NewAcceptorGroup(logging.NoLog{}),
+		TxAcceptorGroup:          snow.NewAcceptorGroup(logging.NoLog{}),
+		VertexAcceptorGroup:      snow.NewAcceptorGroup(logging.NoLog{}),
+		Server:                   apiServer,
+		Subnets:                  s,
+		ChainIdleShutdownTimeout: time.Hour,
+	}).(*manager)
+
+	chainParams := ChainParameters{
+		ID:       ids.GenerateTestID(),
+		SubnetID: subnetID,
+		VMID:     ids.GenerateTestID(),
+	}
+	ctx := snowtest.ConsensusContext(snowtest.Context(t, chainParams.ID))
+	ctx.State.Set(snow.EngineState{
+		State: snow.NormalOp,
+	})
+
+	chain := handler.NewMockHandler(ctrl)
+	chain.EXPECT().Context().Return(ctx).AnyTimes()
+	chain.EXPECT().Stop(gomock.Any())
+	chain.EXPECT().AwaitStopped(gomock.Any()).Return(time.Duration(0), nil)
+
+	m.chains[chainParams.ID] = chain
+	require.NoError(m.trackIdleChain(chainParams))
+
+	m.shutdownIdleChain(context.Background(), chainParams.ID)
+	require.Empty(m.LoadedChains())
+	require.Equal([]ids.ID{chainParams.ID}, m.UnloadedChains())
+	require.Zero(m.chainsQueue.Len())
+
+	// Another node joining the subnet doesn't re-create the chain.
+	require.NoError(m.Validators.AddStaker(subnetID, ids.GenerateTestNodeID(), nil, ids.Empty, 1))
+	require.Equal([]ids.ID{chainParams.ID}, m.UnloadedChains())
+	require.Zero(m.chainsQueue.Len())
+
+	// The chain is re-created once this node validates the subnet.
+	require.NoError(m.Validators.AddStaker(subnetID, m.NodeID, nil, ids.Empty, 1))
+	require.Empty(m.UnloadedChains())
+
+	queuedChainParams, ok := m.chainsQueue.Pop()
+	require.True(ok)
+	require.Equal(chainParams, queuedChainParams)
+}
+
+func TestIdleChainRecreatedOnAPICall(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	s, err := NewSubnets(ids.EmptyNodeID, map[ids.ID]subnets.Config{
+		constants.PrimaryNetworkID: {},
+	})
+	require.NoError(err)
+
+	chainParams := ChainParameters{
+		ID:       ids.GenerateTestID(),
+		SubnetID: ids.GenerateTestID(),
+		VMID:     ids.GenerateTestID(),
+	}
+	ctx := snowtest.ConsensusContext(snowtest.Context(t, chainParams.ID))
+	ctx.State.Set(snow.EngineState{
+		State: snow.NormalOp,
+	})
+
+	chain := handler.NewMockHandler(ctrl)
+	chain.EXPECT().Context().Return(ctx).AnyTimes()
+	chain.EXPECT().Stop(gomock.Any())
+	chain.EXPECT().AwaitStopped(gomock.Any()).Return(time.Duration(0), nil)
+
+	var onAPICall func()
+	apiServer := server.NewMockServer(ctrl)
+	apiServer.EXPECT().DeregisterChain(chainParams.ID, gomock.Any()).Do(
+		func(_ ids.ID, onRequest func()) {
+			onAPICall = onRequest
+		},
+	)
+
+	m := New(&ManagerConfig{
+		Log:                      logging.NoLog{},
+		NodeID:                   ids.GenerateTestNodeID(),
+		Validators:               validators.NewManager(),
+		Metrics:                  metrics.NewMultiGatherer(),
+		BlockAcceptorGroup:       snow.NewAcceptorGroup(logging.NoLog{}),
+		TxAcceptorGroup:          snow.NewAcceptorGroup(logging.NoLog{}),
+		VertexAcceptorGroup:      snow.NewAcceptorGroup(logging.NoLog{}),
+		Server:                   apiServer,
+		Subnets:                  s,
+		ChainIdleShutdownTimeout: time.Hour,
+	}).(*manager)
+
+	m.chains[chainParams.ID] = chain
+	require.NoError(m.trackIdleChain(chainParams))
+
+	m.shutdownIdleChain(context.Background(), chainParams.ID)
+	require.Equal([]ids.ID{chainParams.ID}, m.UnloadedChains())
+	require.Zero(m.chainsQueue.Len())
+
+	// The chain is re-created only once, however many API calls arrive before
+	// it is running again.
+	require.NotNil(onAPICall)
+	onAPICall()
+	onAPICall()
+	require.Empty(m.UnloadedChains())
+	require.Equal(1, m.chainsQueue.Len())
+
+	queuedChainParams, ok := m.chainsQueue.Pop()
+	require.True(ok)
+	require.Equal(chainParams, queuedChainParams)
+}
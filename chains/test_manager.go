@@ -49,6 +49,14 @@ func (testManager) IsBootstrapped(ids.ID) bool {
 	return false
 }
 
+func (testManager) LoadedChains() []ids.ID {
+	return nil
+}
+
+func (testManager) UnloadedChains() []ids.ID {
+	return nil
+}
+
 func (testManager) Lookup(s string) (ids.ID, error) {
 	return ids.FromString(s)
 }
@@ -73,10 +73,12 @@ var (
 	errInvalidDelegationFee                   = errors.New("delegation fee must be in the range [0, 1,000,000]")
 	errInvalidMinStakeDuration                = errors.New("min stake duration must be > 0")
 	errMinStakeDurationAboveMax               = errors.New("max stake duration can't be less than min stake duration")
+	errInvalidRemovalGracePeriod              = errors.New("subnet validator removal grace period must be >= 0")
 	errStakeMaxConsumptionTooLarge            = fmt.Errorf("max stake consumption must be less than or equal to %d", reward.PercentDenominator)
 	errStakeMaxConsumptionBelowMin            = errors.New("stake max consumption can't be less than min stake consumption")
 	errStakeMintingPeriodBelowMin             = errors.New("stake minting period can't be less than max stake duration")
 	errCannotTrackPrimaryNetwork              = errors.New("cannot track primary network")
+	errDuplicateChainCreationSubnet           = errors.New("subnet listed more than once in the chain creation order")
 	errStakingKeyContentUnset                 = fmt.Errorf("%s key not set but %s set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errStakingCertContentUnset                = fmt.Errorf("%s key set but %s not set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errMissingStakingSigningKeyFile           = errors.New("missing staking signing key file")
@@ -85,6 +87,9 @@ var (
 	errCannotReadDirectory                    = errors.New("cannot read directory")
 	errUnmarshalling                          = errors.New("unmarshalling failed")
 	errFileDoesNotExist                       = errors.New("file does not exist")
+	errCreateSubnetTxFeeBelowTxFee            = errors.New("create subnet tx fee can't be less than the tx fee")
+	errCreateBlockchainTxFeeBelowTxFee        = errors.New("create blockchain tx fee can't be less than the tx fee")
+	errNonPositiveConsensusShutdownTimeout    = fmt.Errorf("%s must be > 0", ConsensusShutdownTimeoutKey)
 )
 
 func getConsensusConfig(v *viper.Viper) snowball.Parameters {
@@ -197,6 +202,14 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 	}, nil
 }
 
+func getConsensusShutdownTimeout(v *viper.Viper) (time.Duration, error) {
+	timeout := v.GetDuration(ConsensusShutdownTimeoutKey)
+	if timeout <= 0 {
+		return 0, errNonPositiveConsensusShutdownTimeout
+	}
+	return timeout, nil
+}
+
 func getRouterHealthConfig(v *viper.Viper, halflife time.Duration) (router.HealthConfig, error) {
 	config := router.HealthConfig{
 		MaxDropRate:            v.GetFloat64(RouterHealthMaxDropRateKey),
@@ -351,12 +364,14 @@ func getNetworkConfig(
 		TimeoutConfig: network.TimeoutConfig{
 			PingPongTimeout:      v.GetDuration(NetworkPingTimeoutKey),
 			ReadHandshakeTimeout: v.GetDuration(NetworkReadHandshakeTimeoutKey),
+			PeerHandshakeTimeout: v.GetDuration(NetworkPeerHandshakeTimeoutKey),
 		},
 
 		PeerListGossipConfig: network.PeerListGossipConfig{
 			PeerListNumValidatorIPs: v.GetUint32(NetworkPeerListNumValidatorIPsKey),
 			PeerListPullGossipFreq:  v.GetDuration(NetworkPeerListPullGossipFreqKey),
 			PeerListBloomResetFreq:  v.GetDuration(NetworkPeerListBloomResetFreqKey),
+			PeerListAdaptiveGossip:  v.GetBool(NetworkPeerListAdaptiveGossipKey),
 		},
 
 		DelayConfig: network.DelayConfig{
@@ -366,6 +381,7 @@ func getNetworkConfig(
 
 		MaxClockDifference:           v.GetDuration(NetworkMaxClockDifferenceKey),
 		CompressionType:              compressionType,
+		CompressionThreshold:         int(v.GetUint(NetworkCompressionThresholdKey)),
 		PingFrequency:                v.GetDuration(NetworkPingFrequencyKey),
 		AllowPrivateIPs:              allowPrivateIPs,
 		UptimeMetricFreq:             v.GetDuration(UptimeMetricFreqKey),
@@ -412,6 +428,8 @@ func getNetworkConfig(
 		return network.Config{}, fmt.Errorf("%s must be > %s", NetworkPingTimeoutKey, NetworkPingFrequencyKey)
 	case config.ReadHandshakeTimeout < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkReadHandshakeTimeoutKey)
+	case config.PeerHandshakeTimeout < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerHandshakeTimeoutKey)
 	case config.MaxClockDifference < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkMaxClockDifferenceKey)
 	}
@@ -483,6 +501,7 @@ func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig,
 		BootstrapMaxTimeGetAncestors:            v.GetDuration(BootstrapMaxTimeGetAncestorsKey),
 		BootstrapAncestorsMaxContainersSent:     int(v.GetUint(BootstrapAncestorsMaxContainersSentKey)),
 		BootstrapAncestorsMaxContainersReceived: int(v.GetUint(BootstrapAncestorsMaxContainersReceivedKey)),
+		MinBootstrapPeers:                       int(v.GetUint(MinBootstrapPeersKey)),
 	}
 
 	// TODO: Add a "BootstrappersKey" flag to more clearly enforce ID and IP
@@ -740,6 +759,7 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		config.RewardConfig.MintingPeriod = v.GetDuration(StakeMintingPeriodKey)
 		config.RewardConfig.SupplyCap = v.GetUint64(StakeSupplyCapKey)
 		config.MinDelegationFee = v.GetUint32(MinDelegatorFeeKey)
+		config.SubnetValidatorRemovalGracePeriod = v.GetDuration(RemovalGracePeriodKey)
 		switch {
 		case config.UptimeRequirement < 0 || config.UptimeRequirement > 1:
 			return node.StakingConfig{}, errInvalidUptimeRequirement
@@ -751,6 +771,8 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 			return node.StakingConfig{}, errInvalidMinStakeDuration
 		case config.MaxStakeDuration < config.MinStakeDuration:
 			return node.StakingConfig{}, errMinStakeDurationAboveMax
+		case config.SubnetValidatorRemovalGracePeriod < 0:
+			return node.StakingConfig{}, errInvalidRemovalGracePeriod
 		case config.RewardConfig.MaxConsumptionRate > reward.PercentDenominator:
 			return node.StakingConfig{}, errStakeMaxConsumptionTooLarge
 		case config.RewardConfig.MaxConsumptionRate < config.RewardConfig.MinConsumptionRate:
@@ -764,9 +786,9 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 	return config, nil
 }
 
-func getTxFeeConfig(v *viper.Viper, networkID uint32) fee.StaticConfig {
+func getTxFeeConfig(v *viper.Viper, networkID uint32) (fee.StaticConfig, error) {
 	if networkID != constants.MainnetID && networkID != constants.FujiID {
-		return fee.StaticConfig{
+		feeConfig := fee.StaticConfig{
 			TxFee:                         v.GetUint64(TxFeeKey),
 			CreateAssetTxFee:              v.GetUint64(CreateAssetTxFeeKey),
 			CreateSubnetTxFee:             v.GetUint64(CreateSubnetTxFeeKey),
@@ -777,8 +799,15 @@ func getTxFeeConfig(v *viper.Viper, networkID uint32) fee.StaticConfig {
 			AddSubnetValidatorFee:         v.GetUint64(AddSubnetValidatorFeeKey),
 			AddSubnetDelegatorFee:         v.GetUint64(AddSubnetDelegatorFeeKey),
 		}
+		switch {
+		case feeConfig.CreateSubnetTxFee < feeConfig.TxFee:
+			return fee.StaticConfig{}, errCreateSubnetTxFeeBelowTxFee
+		case feeConfig.CreateBlockchainTxFee < feeConfig.TxFee:
+			return fee.StaticConfig{}, errCreateBlockchainTxFeeBelowTxFee
+		}
+		return feeConfig, nil
 	}
-	return genesis.GetTxFeeConfig(networkID)
+	return genesis.GetTxFeeConfig(networkID), nil
 }
 
 func getGenesisData(v *viper.Viper, networkID uint32, stakingCfg *genesis.StakingConfig) ([]byte, ids.ID, error) {
@@ -819,6 +848,28 @@ func getTrackedSubnets(v *viper.Viper) (set.Set[ids.ID], error) {
 	return trackedSubnetIDs, nil
 }
 
+func getChainCreationOrder(v *viper.Viper) ([]ids.ID, error) {
+	subnetsStr := v.GetString(ChainCreationOrderKey)
+	subnetsStrs := strings.Split(subnetsStr, ",")
+	subnetIDs := make([]ids.ID, 0, len(subnetsStrs))
+	seen := set.NewSet[ids.ID](len(subnetsStrs))
+	for _, subnet := range subnetsStrs {
+		if subnet == "" {
+			continue
+		}
+		subnetID, err := ids.FromString(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse subnetID %q: %w", subnet, err)
+		}
+		if seen.Contains(subnetID) {
+			return nil, fmt.Errorf("%w: %s", errDuplicateChainCreationSubnet, subnetID)
+		}
+		seen.Add(subnetID)
+		subnetIDs = append(subnetIDs, subnetID)
+	}
+	return subnetIDs, nil
+}
+
 func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, error) {
 	var (
 		configBytes []byte
@@ -1084,10 +1135,15 @@ func getSubnetConfigsFromDir(v *viper.Viper, subnetIDs []ids.ID) (map[ids.ID]sub
 
 func getDefaultSubnetConfig(v *viper.Viper) subnets.Config {
 	return subnets.Config{
-		ConsensusParameters:         getConsensusConfig(v),
-		ValidatorOnly:               false,
-		ProposerMinBlockDelay:       proposervm.DefaultMinBlockDelay,
-		ProposerNumHistoricalBlocks: proposervm.DefaultNumHistoricalBlocks,
+		ConsensusParameters:            getConsensusConfig(v),
+		ValidatorOnly:                  false,
+		ProposerMinBlockDelay:          proposervm.DefaultMinBlockDelay,
+		ProposerFreeForAllDelay:        proposervm.DefaultFreeForAllDelay,
+		ProposerBuildJitter:            proposervm.DefaultBuildJitter,
+		ProposerBuildBlockRetries:      proposervm.DefaultBuildBlockRetries,
+		ProposerBuildBlockRetryBackoff: proposervm.DefaultBuildBlockRetryBackoff,
+		ProposerNumHistoricalBlocks:    proposervm.DefaultNumHistoricalBlocks,
+		ProposerActivationWarnings:     proposervm.DefaultActivationWarnings,
 	}
 }
 
@@ -1209,9 +1265,9 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, err
 	}
 
-	nodeConfig.ConsensusShutdownTimeout = v.GetDuration(ConsensusShutdownTimeoutKey)
-	if nodeConfig.ConsensusShutdownTimeout < 0 {
-		return node.Config{}, fmt.Errorf("%q must be >= 0", ConsensusShutdownTimeoutKey)
+	nodeConfig.ConsensusShutdownTimeout, err = getConsensusShutdownTimeout(v)
+	if err != nil {
+		return node.Config{}, err
 	}
 
 	// Gossiping
@@ -1264,6 +1320,31 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, err
 	}
 
+	nodeConfig.ChainCreationConcurrency = int(v.GetUint(ChainCreationConcurrencyKey))
+	if nodeConfig.ChainCreationConcurrency <= 0 {
+		return node.Config{}, fmt.Errorf("%s must be > 0", ChainCreationConcurrencyKey)
+	}
+
+	nodeConfig.ChainCreationOrder, err = getChainCreationOrder(v)
+	if err != nil {
+		return node.Config{}, err
+	}
+
+	nodeConfig.ChainIdleShutdownTimeout = v.GetDuration(ChainIdleShutdownTimeoutKey)
+	if nodeConfig.ChainIdleShutdownTimeout < 0 {
+		return node.Config{}, fmt.Errorf("%s must be >= 0", ChainIdleShutdownTimeoutKey)
+	}
+
+	nodeConfig.MaxChainFxs = v.GetInt(MaxChainFxsKey)
+	if nodeConfig.MaxChainFxs < 0 {
+		return node.Config{}, fmt.Errorf("%s must be >= 0", MaxChainFxsKey)
+	}
+
+	nodeConfig.MaxTxInputs = v.GetInt(MaxTxInputsKey)
+	if nodeConfig.MaxTxInputs < 1 {
+		return node.Config{}, fmt.Errorf("%s must be >= 1", MaxTxInputsKey)
+	}
+
 	// HTTP APIs
 	nodeConfig.HTTPConfig, err = getHTTPConfig(v)
 	if err != nil {
@@ -1331,7 +1412,10 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	nodeConfig.FdLimit = v.GetUint64(FdLimitKey)
 
 	// Tx Fee
-	nodeConfig.StaticConfig = getTxFeeConfig(v, nodeConfig.NetworkID)
+	nodeConfig.StaticConfig, err = getTxFeeConfig(v, nodeConfig.NetworkID)
+	if err != nil {
+		return node.Config{}, err
+	}
 
 	// Genesis Data
 	genesisStakingCfg := nodeConfig.StakingConfig.StakingConfig
@@ -11,15 +11,18 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/constants"
 )
 
 const chainConfigFilenameExtention = ".ex"
@@ -541,6 +544,140 @@ func TestGetSubnetConfigsFromFlags(t *testing.T) {
 	}
 }
 
+func TestGetTxFeeConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      map[string]uint64
+		expectedErr error
+	}{
+		{
+			name:        "defaults",
+			expectedErr: nil,
+		},
+		{
+			name: "create subnet tx fee below tx fee",
+			values: map[string]uint64{
+				TxFeeKey:             2,
+				CreateSubnetTxFeeKey: 1,
+			},
+			expectedErr: errCreateSubnetTxFeeBelowTxFee,
+		},
+		{
+			name: "create blockchain tx fee below tx fee",
+			values: map[string]uint64{
+				TxFeeKey:                 2,
+				CreateSubnetTxFeeKey:     2,
+				CreateBlockchainTxFeeKey: 1,
+			},
+			expectedErr: errCreateBlockchainTxFeeBelowTxFee,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := setupViperFlags()
+			for key, value := range test.values {
+				v.Set(key, value)
+			}
+
+			_, err := getTxFeeConfig(v, constants.LocalID)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestGetStakingConfigRemovalGracePeriod(t *testing.T) {
+	tests := []struct {
+		name                string
+		networkID           uint32
+		gracePeriod         time.Duration
+		expectedGracePeriod time.Duration
+		expectedErr         error
+	}{
+		{
+			name:                "custom network",
+			networkID:           constants.LocalID,
+			gracePeriod:         time.Hour,
+			expectedGracePeriod: time.Hour,
+			expectedErr:         nil,
+		},
+		{
+			name:                "public network ignores flag",
+			networkID:           constants.FujiID,
+			gracePeriod:         time.Hour,
+			expectedGracePeriod: genesis.FujiParams.SubnetValidatorRemovalGracePeriod,
+			expectedErr:         nil,
+		},
+		{
+			name:        "negative",
+			networkID:   constants.LocalID,
+			gracePeriod: -time.Second,
+			expectedErr: errInvalidRemovalGracePeriod,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(StakingEphemeralCertEnabledKey, true)
+			v.Set(StakingEphemeralSignerEnabledKey, true)
+			v.Set(RemovalGracePeriodKey, test.gracePeriod)
+
+			config, err := getStakingConfig(v, test.networkID)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedGracePeriod, config.SubnetValidatorRemovalGracePeriod)
+		})
+	}
+}
+
+func TestGetConsensusShutdownTimeout(t *testing.T) {
+	tests := []struct {
+		name            string
+		timeout         string
+		expectedTimeout time.Duration
+		expectedErr     error
+	}{
+		{
+			name:            "default",
+			expectedTimeout: constants.DefaultConsensusShutdownTimeout,
+			expectedErr:     nil,
+		},
+		{
+			name:            "positive",
+			timeout:         "1s",
+			expectedTimeout: time.Second,
+			expectedErr:     nil,
+		},
+		{
+			name:        "zero",
+			timeout:     "0s",
+			expectedErr: errNonPositiveConsensusShutdownTimeout,
+		},
+		{
+			name:        "negative",
+			timeout:     "-1s",
+			expectedErr: errNonPositiveConsensusShutdownTimeout,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			if test.timeout != "" {
+				v.Set(ConsensusShutdownTimeoutKey, test.timeout)
+			}
+
+			timeout, err := getConsensusShutdownTimeout(v)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedTimeout, timeout)
+		})
+	}
+}
+
 // setups config json file and writes content
 func setupConfigJSON(t *testing.T, rootPath string, value string) string {
 	configFilePath := filepath.Join(rootPath, "config.json")
@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/database/leveldb"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/pebble"
@@ -24,6 +25,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/dynamicip"
 	"github.com/ava-labs/avalanchego/utils/ulimit"
 	"github.com/ava-labs/avalanchego/utils/units"
+
+	pchaintxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
 const (
@@ -130,6 +133,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Uint(NetworkPeerListNumValidatorIPsKey, constants.DefaultNetworkPeerListNumValidatorIPs, "Number of validator IPs to gossip to other nodes")
 	fs.Duration(NetworkPeerListPullGossipFreqKey, constants.DefaultNetworkPeerListPullGossipFreq, "Frequency to request peers from other nodes")
 	fs.Duration(NetworkPeerListBloomResetFreqKey, constants.DefaultNetworkPeerListBloomResetFreq, "Frequency to recalculate the bloom filter used to request new peers from other nodes")
+	fs.Bool(NetworkPeerListAdaptiveGossipKey, false, "If true, gossip fewer validator IPs and request peers less frequently as the number of connected peers grows")
 
 	// Public IP Resolution
 	fs.String(PublicIPKey, "", "Public IP of this node for P2P communication")
@@ -150,10 +154,12 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(NetworkTimeoutHalflifeKey, constants.DefaultNetworkTimeoutHalflife, "Halflife of average network response time. Higher value --> network timeout is less volatile. Can't be 0")
 	fs.Float64(NetworkTimeoutCoefficientKey, constants.DefaultNetworkTimeoutCoefficient, "Multiplied by average network response time to get the network timeout. Must be >= 1")
 	fs.Duration(NetworkReadHandshakeTimeoutKey, constants.DefaultNetworkReadHandshakeTimeout, "Timeout value for reading handshake messages")
+	fs.Duration(NetworkPeerHandshakeTimeoutKey, constants.DefaultNetworkPeerHandshakeTimeout, "Timeout for a peer to finish the p2p handshake. If 0, the p2p handshake doesn't time out")
 	fs.Duration(NetworkPingTimeoutKey, constants.DefaultPingPongTimeout, "Timeout value for Ping-Pong with a peer")
 	fs.Duration(NetworkPingFrequencyKey, constants.DefaultPingFrequency, "Frequency of pinging other peers")
 
 	fs.String(NetworkCompressionTypeKey, constants.DefaultNetworkCompressionType.String(), fmt.Sprintf("Compression type for outbound messages. Must be one of [%s, %s]", compression.TypeZstd, compression.TypeNone))
+	fs.Uint(NetworkCompressionThresholdKey, constants.DefaultNetworkCompressionThreshold, "Outbound messages smaller than this many bytes are sent uncompressed")
 
 	fs.Duration(NetworkMaxClockDifferenceKey, constants.DefaultNetworkMaxClockDifference, "Max allowed clock difference value between this node and peers")
 	// Note: The default value is set to false here because the default
@@ -264,6 +270,8 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(MinStakeDurationKey, genesis.LocalParams.MinStakeDuration, "Minimum staking duration")
 	// Maximum Stake Duration
 	fs.Duration(MaxStakeDurationKey, genesis.LocalParams.MaxStakeDuration, "Maximum staking duration")
+	// Subnet Validator Removal Grace Period
+	fs.Duration(RemovalGracePeriodKey, genesis.LocalParams.SubnetValidatorRemovalGracePeriod, "Amount of time after a permissioned subnet validator's end time before it is removed from the validator set, once the E upgrade is activated")
 	// Stake Reward Configs
 	fs.Uint64(StakeMaxConsumptionRateKey, genesis.LocalParams.RewardConfig.MaxConsumptionRate, "Maximum consumption rate of the remaining tokens to mint in the staking function")
 	fs.Uint64(StakeMinConsumptionRateKey, genesis.LocalParams.RewardConfig.MinConsumptionRate, "Minimum consumption rate of the remaining tokens to mint in the staking function")
@@ -271,6 +279,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Uint64(StakeSupplyCapKey, genesis.LocalParams.RewardConfig.SupplyCap, "Supply cap of the staking function")
 	// Subnets
 	fs.String(TrackSubnetsKey, "", "List of subnets for the node to track. A node tracking a subnet will track the uptimes of the subnet validators and attempt to sync all the chains in the subnet. Before validating a subnet, a node should be tracking the subnet to avoid impacting their subnet validation uptime")
+	fs.Uint(ChainCreationConcurrencyKey, chains.DefaultChainCreationConcurrency, "Maximum number of chains to create concurrently")
+	fs.String(ChainCreationOrderKey, constants.PrimaryNetworkID.String(), "Comma separated list of subnets whose chains are created before the chains of other subnets, in the order listed")
+	fs.Duration(ChainIdleShutdownTimeoutKey, 0, "Shut down subnet chains that haven't accepted a container for this long. If 0, idle chains are never shut down")
+	fs.Int(MaxChainFxsKey, pchaintxs.DefaultMaxFxIDs, "Maximum number of feature extensions a new chain may run. Once the E upgrade is activated, CreateChainTxs requesting more are rejected. Should be the same on all nodes of a network")
+	fs.Int(MaxTxInputsKey, pchaintxs.DefaultMaxInputs, "Maximum number of inputs a P-Chain tx may consume, including imported inputs, to be added to the mempool")
 
 	// State syncing
 	fs.String(StateSyncIPsKey, "", "Comma separated list of state sync peer ips to connect to. Example: 127.0.0.1:9630,127.0.0.1:9631")
@@ -281,6 +294,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.String(BootstrapIPsKey, "", "Comma separated list of bootstrap peer ips to connect to. Example: 127.0.0.1:9630,127.0.0.1:9631")
 	fs.String(BootstrapIDsKey, "", "Comma separated list of bootstrap peer ids to connect to. Example: NodeID-JR4dVmy6ffUGAKCBDkyCbeZbyHQBeDsET,NodeID-8CrVPQZ4VSqgL8zTdvL14G8HqAfrBr4z")
 	fs.Duration(BootstrapBeaconConnectionTimeoutKey, time.Minute, "Timeout before emitting a warn log when connecting to bootstrapping beacons")
+	fs.Uint(MinBootstrapPeersKey, 1, "Minimum number of beacons that must be connected before a chain starts bootstrapping")
 	fs.Duration(BootstrapMaxTimeGetAncestorsKey, 50*time.Millisecond, "Max Time to spend fetching a container and its ancestors when responding to a GetAncestors")
 	fs.Uint(BootstrapAncestorsMaxContainersSentKey, 2000, "Max number of containers in an Ancestors message sent by this node")
 	fs.Uint(BootstrapAncestorsMaxContainersReceivedKey, 2000, "This node reads at most this many containers from an incoming Ancestors message")
@@ -34,6 +34,7 @@ const (
 	MinDelegatorFeeKey               = "min-delegation-fee"
 	MinStakeDurationKey              = "min-stake-duration"
 	MaxStakeDurationKey              = "max-stake-duration"
+	RemovalGracePeriodKey            = "subnet-validator-removal-grace-period"
 	StakeMaxConsumptionRateKey       = "stake-max-consumption-rate"
 	StakeMinConsumptionRateKey       = "stake-min-consumption-rate"
 	StakeMintingPeriodKey            = "stake-minting-period"
@@ -93,12 +94,15 @@ const (
 	NetworkPeerListNumValidatorIPsKey                  = "network-peer-list-num-validator-ips"
 	NetworkPeerListPullGossipFreqKey                   = "network-peer-list-pull-gossip-frequency"
 	NetworkPeerListBloomResetFreqKey                   = "network-peer-list-bloom-reset-frequency"
+	NetworkPeerListAdaptiveGossipKey                   = "network-peer-list-adaptive-gossip"
 	NetworkInitialReconnectDelayKey                    = "network-initial-reconnect-delay"
 	NetworkReadHandshakeTimeoutKey                     = "network-read-handshake-timeout"
+	NetworkPeerHandshakeTimeoutKey                     = "network-peer-handshake-timeout"
 	NetworkPingTimeoutKey                              = "network-ping-timeout"
 	NetworkPingFrequencyKey                            = "network-ping-frequency"
 	NetworkMaxReconnectDelayKey                        = "network-max-reconnect-delay"
 	NetworkCompressionTypeKey                          = "network-compression-type"
+	NetworkCompressionThresholdKey                     = "network-compression-threshold"
 	NetworkMaxClockDifferenceKey                       = "network-max-clock-difference"
 	NetworkAllowPrivateIPsKey                          = "network-allow-private-ips"
 	NetworkRequireValidatorToConnectKey                = "network-require-validator-to-connect"
@@ -134,6 +138,11 @@ const (
 	SnowMaxTimeProcessingKey                           = "snow-max-time-processing"
 	PartialSyncPrimaryNetworkKey                       = "partial-sync-primary-network"
 	TrackSubnetsKey                                    = "track-subnets"
+	MaxChainFxsKey                                     = "max-chain-fxs"
+	ChainCreationConcurrencyKey                        = "chain-creation-concurrency"
+	ChainCreationOrderKey                              = "chain-creation-order"
+	ChainIdleShutdownTimeoutKey                        = "chain-idle-shutdown-timeout"
+	MaxTxInputsKey                                     = "max-tx-inputs"
 	AdminAPIEnabledKey                                 = "api-admin-enabled"
 	InfoAPIEnabledKey                                  = "api-info-enabled"
 	KeystoreAPIEnabledKey                              = "api-keystore-enabled"
@@ -154,6 +163,7 @@ const (
 	PluginDirKey                                       = "plugin-dir"
 	BootstrapBeaconConnectionTimeoutKey                = "bootstrap-beacon-connection-timeout"
 	BootstrapMaxTimeGetAncestorsKey                    = "bootstrap-max-time-get-ancestors"
+	MinBootstrapPeersKey                               = "min-bootstrap-peers"
 	BootstrapAncestorsMaxContainersSentKey             = "bootstrap-ancestors-max-containers-sent"
 	BootstrapAncestorsMaxContainersReceivedKey         = "bootstrap-ancestors-max-containers-received"
 	ChainDataDirKey                                    = "chain-data-dir"
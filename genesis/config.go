@@ -10,21 +10,31 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 )
 
+// weiPerNAVAX is the conversion rate between the C-Chain and the other chains
+const weiPerNAVAX = 1_000_000_000
+
 var (
 	_ utils.Sortable[Allocation] = Allocation{}
 
-	errInvalidGenesisJSON = errors.New("could not unmarshal genesis JSON")
+	errInvalidGenesisJSON   = errors.New("could not unmarshal genesis JSON")
+	errInvalidCChainGenesis = errors.New("could not unmarshal C-Chain genesis JSON")
+
+	errDuplicateCChainAllocation = errors.New("duplicate C-Chain allocation")
+	errAllocationOverflow        = errors.New("allocation overflow")
 )
 
 type LockedAmount struct {
@@ -54,6 +64,64 @@ func (a Allocation) Unparse(networkID uint32) (UnparsedAllocation, error) {
 	return ua, err
 }
 
+// XChainHolder holds an amount of an X-Chain asset at genesis.
+type XChainHolder struct {
+	AVAXAddr ids.ShortID `json:"avaxAddr"`
+	Amount   uint64      `json:"amount"`
+}
+
+func (h XChainHolder) Unparse(networkID uint32) (UnparsedXChainHolder, error) {
+	avaxAddr, err := address.Format(
+		"X",
+		constants.GetHRP(networkID),
+		h.AVAXAddr.Bytes(),
+	)
+	return UnparsedXChainHolder{
+		AVAXAddr: avaxAddr,
+		Amount:   h.Amount,
+	}, err
+}
+
+// XChainAsset is a fixed cap asset created on the X-Chain at genesis, in
+// addition to AVAX.
+type XChainAsset struct {
+	Name           string         `json:"name"`
+	Symbol         string         `json:"symbol"`
+	Denomination   uint8          `json:"denomination"`
+	InitialHolders []XChainHolder `json:"initialHolders"`
+}
+
+func (a XChainAsset) Unparse(networkID uint32) (UnparsedXChainAsset, error) {
+	ua := UnparsedXChainAsset{
+		Name:           a.Name,
+		Symbol:         a.Symbol,
+		Denomination:   a.Denomination,
+		InitialHolders: make([]UnparsedXChainHolder, len(a.InitialHolders)),
+	}
+	for i, h := range a.InitialHolders {
+		uh, err := h.Unparse(networkID)
+		if err != nil {
+			return ua, err
+		}
+		ua.InitialHolders[i] = uh
+	}
+	return ua, nil
+}
+
+// CChainAllocation funds an account of the C-Chain at genesis.
+type CChainAllocation struct {
+	ETHAddr ids.ShortID `json:"ethAddr"`
+	// Balance in wei
+	Balance *big.Int `json:"balance"`
+}
+
+func (a CChainAllocation) Unparse() UnparsedCChainAllocation {
+	return UnparsedCChainAllocation{
+		ETHAddr: "0x" + hex.EncodeToString(a.ETHAddr.Bytes()),
+		Balance: "0x" + a.Balance.Text(16),
+	}
+}
+
 func (a Allocation) Compare(other Allocation) int {
 	if amountCmp := cmp.Compare(a.InitialAmount, other.InitialAmount); amountCmp != 0 {
 		return amountCmp
@@ -96,6 +164,15 @@ type Config struct {
 
 	CChainGenesis string `json:"cChainGenesis"`
 
+	// CChainAllocations are added to the alloc of CChainGenesis
+	CChainAllocations []CChainAllocation `json:"cChainAllocations"`
+
+	XChainAssets []XChainAsset `json:"xChainAssets"`
+
+	// ExpectedSupply is the amount of nAVAX that the allocations of the
+	// genesis are expected to sum to. It is ignored if zero.
+	ExpectedSupply uint64 `json:"expectedSupply,omitempty"`
+
 	Message string `json:"message"`
 }
 
@@ -110,6 +187,7 @@ func (c Config) Unparse() (UnparsedConfig, error) {
 		InitialStakers:             make([]UnparsedStaker, len(c.InitialStakers)),
 		CChainGenesis:              c.CChainGenesis,
 		Message:                    c.Message,
+		ExpectedSupply:             c.ExpectedSupply,
 	}
 	for i, a := range c.Allocations {
 		ua, err := a.Unparse(uc.NetworkID)
@@ -136,6 +214,16 @@ func (c Config) Unparse() (UnparsedConfig, error) {
 		}
 		uc.InitialStakers[i] = uis
 	}
+	for _, a := range c.CChainAllocations {
+		uc.CChainAllocations = append(uc.CChainAllocations, a.Unparse())
+	}
+	for _, a := range c.XChainAssets {
+		ua, err := a.Unparse(c.NetworkID)
+		if err != nil {
+			return uc, err
+		}
+		uc.XChainAssets = append(uc.XChainAssets, ua)
+	}
 
 	return uc, nil
 }
@@ -158,6 +246,126 @@ func (c *Config) InitialSupply() (uint64, error) {
 	return initialSupply, nil
 }
 
+// TotalAllocation returns the amount of nAVAX allocated at genesis on the
+// X-Chain, the P-Chain, and the C-Chain. C-Chain balances are converted from
+// wei, rounding down.
+func (c *Config) TotalAllocation() (uint64, error) {
+	total, err := c.InitialSupply()
+	if err != nil {
+		return 0, err
+	}
+
+	balances, err := c.cChainBalances()
+	if err != nil {
+		return 0, err
+	}
+
+	cChainTotal := new(big.Int)
+	for _, balance := range balances {
+		cChainTotal.Add(cChainTotal, balance)
+	}
+	cChainTotal.Div(cChainTotal, big.NewInt(weiPerNAVAX))
+	if !cChainTotal.IsUint64() {
+		return 0, fmt.Errorf("%w: C-Chain allocations of %s nAVAX", errAllocationOverflow, cChainTotal)
+	}
+	return math.Add64(total, cChainTotal.Uint64())
+}
+
+// cChainBalances returns the wei balance of every account funded in the
+// C-Chain genesis, including CChainAllocations, keyed by address.
+func (c *Config) cChainBalances() (map[string]*big.Int, error) {
+	cChainGenesisJSON, err := c.cChainGenesisWithAllocations()
+	if err != nil {
+		return nil, err
+	}
+	var cChainGenesis struct {
+		Alloc map[string]struct {
+			Balance string `json:"balance"`
+		} `json:"alloc"`
+	}
+	if err := json.Unmarshal([]byte(cChainGenesisJSON), &cChainGenesis); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidCChainGenesis, err)
+	}
+
+	balances := make(map[string]*big.Int, len(cChainGenesis.Alloc))
+	for addr, account := range cChainGenesis.Alloc {
+		balance, ok := new(big.Int).SetString(account.Balance, 0)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q of %s", errInvalidCChainBalance, account.Balance, addr)
+		}
+		balances[addr] = balance
+	}
+	return balances, nil
+}
+
+// cChainGenesisWithAllocations returns CChainGenesis with CChainAllocations
+// added to its alloc.
+func (c *Config) cChainGenesisWithAllocations() (string, error) {
+	if len(c.CChainAllocations) == 0 {
+		return c.CChainGenesis, nil
+	}
+
+	var cChainGenesis map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(c.CChainGenesis), &cChainGenesis); err != nil {
+		return "", fmt.Errorf("%w: %w", errInvalidCChainGenesis, err)
+	}
+	alloc := make(map[string]json.RawMessage)
+	if allocJSON, ok := cChainGenesis["alloc"]; ok {
+		if err := json.Unmarshal(allocJSON, &alloc); err != nil {
+			return "", fmt.Errorf("%w: %w", errInvalidCChainGenesis, err)
+		}
+	}
+
+	// The keys of alloc may or may not be prefixed with 0x and may use any
+	// case, so they are normalized to detect duplicate accounts.
+	allocated := set.NewSet[string](len(alloc) + len(c.CChainAllocations))
+	for addr := range alloc {
+		allocated.Add(strings.ToLower(strings.TrimPrefix(addr, "0x")))
+	}
+	for _, a := range c.CChainAllocations {
+		addr := hex.EncodeToString(a.ETHAddr.Bytes())
+		if allocated.Contains(addr) {
+			return "", fmt.Errorf("%w: 0x%s", errDuplicateCChainAllocation, addr)
+		}
+		allocated.Add(addr)
+
+		accountJSON, err := json.Marshal(map[string]string{
+			"balance": "0x" + a.Balance.Text(16),
+		})
+		if err != nil {
+			return "", err
+		}
+		alloc[addr] = accountJSON
+	}
+
+	allocJSON, err := json.Marshal(alloc)
+	if err != nil {
+		return "", err
+	}
+	cChainGenesis["alloc"] = allocJSON
+
+	cChainGenesisJSON, err := json.Marshal(cChainGenesis)
+	return string(cChainGenesisJSON), err
+}
+
+// CChainID returns the EVM chain ID defined by the C-Chain genesis, or nil if it
+// isn't defined.
+func (c *Config) CChainID() (*big.Int, error) {
+	return parseCChainID([]byte(c.CChainGenesis))
+}
+
+func parseCChainID(cChainGenesisBytes []byte) (*big.Int, error) {
+	var cChainGenesis struct {
+		Config struct {
+			ChainID *big.Int `json:"chainId"`
+		} `json:"config"`
+	}
+	if err := json.Unmarshal(cChainGenesisBytes, &cChainGenesis); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidCChainGenesis, err)
+	}
+	return cChainGenesis.Config.ChainID, nil
+}
+
 var (
 	// MainnetConfig is the config that should be used to generate the mainnet
 	// genesis.
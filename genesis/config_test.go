@@ -4,11 +4,15 @@
 package genesis
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/units"
 )
 
 func TestAllocationCompare(t *testing.T) {
@@ -51,3 +55,131 @@ func TestAllocationCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestUnparsedCChainAllocationParse(t *testing.T) {
+	ethAddr := ids.GenerateTestShortID()
+	ethAddrStr := "0x" + hex.EncodeToString(ethAddr.Bytes())
+
+	tests := []struct {
+		name        string
+		unparsed    UnparsedCChainAllocation
+		expected    CChainAllocation
+		expectedErr error
+	}{
+		{
+			name: "decimal balance",
+			unparsed: UnparsedCChainAllocation{
+				ETHAddr: ethAddrStr,
+				Balance: "1000",
+			},
+			expected: CChainAllocation{
+				ETHAddr: ethAddr,
+				Balance: big.NewInt(1000),
+			},
+		},
+		{
+			name: "hex balance",
+			unparsed: UnparsedCChainAllocation{
+				ETHAddr: ethAddrStr,
+				Balance: "0x3e8",
+			},
+			expected: CChainAllocation{
+				ETHAddr: ethAddr,
+				Balance: big.NewInt(1000),
+			},
+		},
+		{
+			name: "invalid balance",
+			unparsed: UnparsedCChainAllocation{
+				ETHAddr: ethAddrStr,
+				Balance: "one",
+			},
+			expectedErr: errInvalidCChainBalance,
+		},
+		{
+			name: "negative balance",
+			unparsed: UnparsedCChainAllocation{
+				ETHAddr: ethAddrStr,
+				Balance: "-1",
+			},
+			expectedErr: errInvalidCChainBalance,
+		},
+		{
+			name: "invalid address",
+			unparsed: UnparsedCChainAllocation{
+				ETHAddr: "0",
+				Balance: "1000",
+			},
+			expectedErr: errInvalidETHAddress,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			allocation, err := test.unparsed.Parse()
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expected, allocation)
+			require.Equal(ethAddrStr, allocation.Unparse().ETHAddr)
+		})
+	}
+}
+
+func TestCChainGenesisWithAllocations(t *testing.T) {
+	require := require.New(t)
+
+	ethAddr := ids.GenerateTestShortID()
+	config := LocalConfig
+	config.CChainAllocations = []CChainAllocation{
+		{
+			ETHAddr: ethAddr,
+			Balance: big.NewInt(1000),
+		},
+	}
+
+	cChainGenesisJSON, err := config.cChainGenesisWithAllocations()
+	require.NoError(err)
+
+	var cChainGenesis struct {
+		Alloc map[string]struct {
+			Balance string `json:"balance"`
+		} `json:"alloc"`
+	}
+	require.NoError(json.Unmarshal([]byte(cChainGenesisJSON), &cChainGenesis))
+
+	// The existing allocation is kept
+	require.Len(cChainGenesis.Alloc, 2)
+	require.Equal("0x3e8", cChainGenesis.Alloc[hex.EncodeToString(ethAddr.Bytes())].Balance)
+
+	// Allocating to the same account twice is rejected
+	config.CChainAllocations = append(config.CChainAllocations, config.CChainAllocations[0])
+	_, err = config.cChainGenesisWithAllocations()
+	require.ErrorIs(err, errDuplicateCChainAllocation)
+}
+
+func TestTotalAllocation(t *testing.T) {
+	require := require.New(t)
+
+	config := LocalConfig
+	initialSupply, err := config.InitialSupply()
+	require.NoError(err)
+
+	// The local C-Chain genesis funds a single account with 50M AVAX
+	totalAllocation, err := config.TotalAllocation()
+	require.NoError(err)
+	require.Equal(initialSupply+50*units.MegaAvax, totalAllocation)
+
+	// C-Chain allocations are converted from wei
+	config.CChainAllocations = []CChainAllocation{
+		{
+			ETHAddr: ids.GenerateTestShortID(),
+			Balance: new(big.Int).SetUint64(3*weiPerNAVAX - 1),
+		},
+	}
+	totalAllocation, err = config.TotalAllocation()
+	require.NoError(err)
+	require.Equal(initialSupply+50*units.MegaAvax+2, totalAllocation)
+}
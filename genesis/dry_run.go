@@ -0,0 +1,170 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+)
+
+// Summary is a breakdown of the genesis state that a config produces.
+type Summary struct {
+	NetworkID         uint32                    `json:"networkID"`
+	StartTime         uint64                    `json:"startTime"`
+	AVAXAssetID       ids.ID                    `json:"avaxAssetID"`
+	InitialSupply     uint64                    `json:"initialSupply"`
+	XChainAllocations []XChainAllocationSummary `json:"xChainAllocations"`
+	PChainAllocations []PChainAllocationSummary `json:"pChainAllocations"`
+	CChainAllocations []CChainAllocationSummary `json:"cChainAllocations"`
+	Validators        []ValidatorSummary        `json:"validators"`
+	Chains            []ChainSummary            `json:"chains"`
+
+	// Args are the arguments that the genesis builders are invoked with.
+	Args *Args `json:"args"`
+}
+
+// XChainAllocationSummary is an amount of an asset held by an address on the
+// X-Chain at genesis.
+type XChainAllocationSummary struct {
+	Asset   string `json:"asset"`
+	Address string `json:"address"`
+	Amount  uint64 `json:"amount"`
+}
+
+// PChainAllocationSummary is an amount of nAVAX held by an address on the
+// P-Chain at genesis.
+type PChainAllocationSummary struct {
+	Address  string `json:"address"`
+	Amount   uint64 `json:"amount"`
+	Locktime uint64 `json:"locktime"`
+}
+
+// CChainAllocationSummary is the wei balance of an account on the C-Chain at
+// genesis.
+type CChainAllocationSummary struct {
+	Address string   `json:"address"`
+	Balance *big.Int `json:"balance"`
+}
+
+// ValidatorSummary is a primary network validator at genesis.
+type ValidatorSummary struct {
+	NodeID          ids.NodeID                `json:"nodeID"`
+	StartTime       uint64                    `json:"startTime"`
+	EndTime         uint64                    `json:"endTime"`
+	Weight          uint64                    `json:"weight"`
+	DelegationFee   uint32                    `json:"delegationFee"`
+	RewardAddresses []string                  `json:"rewardAddresses"`
+	Staked          []PChainAllocationSummary `json:"staked"`
+}
+
+// ChainSummary is a chain created at genesis.
+type ChainSummary struct {
+	Name     string   `json:"name"`
+	SubnetID ids.ID   `json:"subnetID"`
+	VMID     ids.ID   `json:"vmID"`
+	FxIDs    []ids.ID `json:"fxIDs"`
+}
+
+// DryRun runs the genesis builder on [config] and returns a summary of the
+// genesis state it would produce, without building the P-Chain genesis bytes.
+//
+// Like FromConfig, DryRun doesn't validate [config].
+func DryRun(config *Config) (*Summary, error) {
+	args, err := buildArgs(config)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{
+		NetworkID:     uint32(args.PlatformVMArgs.NetworkID),
+		StartTime:     uint64(args.PlatformVMArgs.Time),
+		AVAXAssetID:   args.AVAXAssetID,
+		InitialSupply: uint64(args.PlatformVMArgs.InitialSupply),
+		Args:          args,
+	}
+
+	assetAliases := maps.Keys(args.AVMArgs.GenesisData)
+	slices.Sort(assetAliases)
+	for _, alias := range assetAliases {
+		asset := args.AVMArgs.GenesisData[alias]
+		for _, state := range asset.InitialState["fixedCap"] {
+			holder, ok := state.(avm.Holder)
+			if !ok {
+				return nil, fmt.Errorf("unexpected initial state %T of %s", state, alias)
+			}
+			summary.XChainAllocations = append(summary.XChainAllocations, XChainAllocationSummary{
+				Asset:   asset.Symbol,
+				Address: holder.Address,
+				Amount:  uint64(holder.Amount),
+			})
+		}
+	}
+
+	summary.PChainAllocations = summarizeUTXOs(args.PlatformVMArgs.UTXOs)
+
+	balances, err := config.cChainBalances()
+	if err != nil {
+		return nil, err
+	}
+	cChainAddrs := maps.Keys(balances)
+	slices.Sort(cChainAddrs)
+	for _, addr := range cChainAddrs {
+		summary.CChainAllocations = append(summary.CChainAllocations, CChainAllocationSummary{
+			Address: addr,
+			Balance: balances[addr],
+		})
+	}
+
+	for _, validator := range args.PlatformVMArgs.Validators {
+		validatorSummary := ValidatorSummary{
+			NodeID:    validator.NodeID,
+			StartTime: uint64(validator.StartTime),
+			EndTime:   uint64(validator.EndTime),
+			Staked:    summarizeUTXOs(validator.Staked),
+		}
+		for _, utxo := range validatorSummary.Staked {
+			validatorSummary.Weight, err = math.Add64(validatorSummary.Weight, utxo.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't calculate the weight of %s: %w", validator.NodeID, err)
+			}
+		}
+		if validator.ExactDelegationFee != nil {
+			validatorSummary.DelegationFee = uint32(*validator.ExactDelegationFee)
+		}
+		if validator.RewardOwner != nil {
+			validatorSummary.RewardAddresses = validator.RewardOwner.Addresses
+		}
+		summary.Validators = append(summary.Validators, validatorSummary)
+	}
+
+	for _, chain := range args.PlatformVMArgs.Chains {
+		summary.Chains = append(summary.Chains, ChainSummary{
+			Name:     chain.Name,
+			SubnetID: chain.SubnetID,
+			VMID:     chain.VMID,
+			FxIDs:    chain.FxIDs,
+		})
+	}
+	return summary, nil
+}
+
+func summarizeUTXOs(utxos []api.UTXO) []PChainAllocationSummary {
+	summaries := make([]PChainAllocationSummary, len(utxos))
+	for i, utxo := range utxos {
+		summaries[i] = PChainAllocationSummary{
+			Address:  utxo.Address,
+			Amount:   uint64(utxo.Amount),
+			Locktime: uint64(utxo.Locktime),
+		}
+	}
+	return summaries
+}
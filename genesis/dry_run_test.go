@@ -0,0 +1,76 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+)
+
+func TestDryRun(t *testing.T) {
+	require := require.New(t)
+
+	config := LocalConfig
+	config.XChainAssets = []XChainAsset{testXChainAsset()}
+
+	summary, err := DryRun(&config)
+	require.NoError(err)
+
+	genesisBytes, avaxAssetID, err := FromConfig(&config)
+	require.NoError(err)
+	require.Equal(avaxAssetID, summary.AVAXAssetID)
+	require.Equal(constants.LocalID, summary.NetworkID)
+	require.Equal(config.StartTime, summary.StartTime)
+
+	// Building the P-Chain genesis from the surfaced args must produce the
+	// same genesis as FromConfig.
+	reply := api.BuildGenesisReply{}
+	ss := api.StaticService{}
+	require.NoError(ss.BuildGenesis(nil, &summary.Args.PlatformVMArgs, &reply))
+	builtBytes, err := formatting.Decode(reply.Encoding, reply.Bytes)
+	require.NoError(err)
+	require.Equal(genesisBytes, builtBytes)
+
+	// Every nAVAX of the initial supply is allocated on the X-Chain, on the
+	// P-Chain, or staked by a genesis validator.
+	allocated := uint64(0)
+	for _, allocation := range summary.XChainAllocations {
+		if allocation.Asset == avaxAlias {
+			allocated += allocation.Amount
+		}
+	}
+	for _, allocation := range summary.PChainAllocations {
+		allocated += allocation.Amount
+	}
+	require.Len(summary.Validators, len(config.InitialStakers))
+	for i, validator := range summary.Validators {
+		require.Equal(config.InitialStakers[i].NodeID, validator.NodeID)
+		require.Equal(config.InitialStakers[i].DelegationFee, validator.DelegationFee)
+		require.Len(validator.RewardAddresses, 1)
+		allocated += validator.Weight
+	}
+	require.Equal(summary.InitialSupply, allocated)
+
+	asset := testXChainAsset()
+	assetAllocations := []XChainAllocationSummary(nil)
+	for _, allocation := range summary.XChainAllocations {
+		if allocation.Asset == asset.Symbol {
+			assetAllocations = append(assetAllocations, allocation)
+		}
+	}
+	require.Len(assetAllocations, len(asset.InitialHolders))
+
+	require.NotEmpty(summary.CChainAllocations)
+
+	require.Len(summary.Chains, 2)
+	require.Equal("X-Chain", summary.Chains[0].Name)
+	require.Equal(constants.AVMID, summary.Chains[0].VMID)
+	require.Equal("C-Chain", summary.Chains[1].Name)
+	require.Equal(constants.EVMID, summary.Chains[1].VMID)
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+// This computes the hashes of the genesis of the standard networks and pins
+// them in genesis_hashes.json.
+//
+// This should only be run when a change of the genesis is intended, as every
+// node must then run with the new genesis.
+func main() {
+	networkIDs := []uint32{
+		constants.MainnetID,
+		constants.FujiID,
+		constants.LocalID,
+	}
+
+	hashes := make(map[string]genesis.Hashes, len(networkIDs))
+	for _, networkID := range networkIDs {
+		networkName := constants.NetworkIDToNetworkName[networkID]
+		networkHashes, err := genesis.ComputeHashes(networkID)
+		if err != nil {
+			log.Fatalf("failed to compute %s genesis hashes: %v", networkName, err)
+		}
+		hashes[networkName] = networkHashes
+	}
+
+	hashesJSON, err := json.MarshalIndent(hashes, "", "\t")
+	if err != nil {
+		log.Fatalf("failed to marshal genesis hashes: %v", err)
+	}
+
+	if err := perms.WriteFile("genesis_hashes.json", hashesJSON, perms.ReadWrite); err != nil {
+		log.Fatalf("failed to write genesis hashes: %v", err)
+	}
+}
@@ -6,7 +6,9 @@ package genesis
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
@@ -30,6 +32,13 @@ import (
 const (
 	defaultEncoding    = formatting.Hex
 	configChainIDAlias = "X"
+	avaxAlias          = "AVAX"
+
+	// Limits of the X-Chain assets created at genesis, matching the limits
+	// the X-Chain enforces on CreateAssetTxs.
+	maxAssetNameLen      = 128
+	maxAssetSymbolLen    = 4
+	maxAssetDenomination = 32
 )
 
 var (
@@ -39,6 +48,7 @@ var (
 	errNoStakeDuration                 = errors.New("initial stake duration must be > 0")
 	errNoStakers                       = errors.New("initial stakers must be > 0")
 	errNoCChainGenesis                 = errors.New("C-Chain genesis cannot be empty")
+	errInvalidCChainID                 = errors.New("C-Chain chain ID must be > 0")
 	errNoTxs                           = errors.New("genesis creates no transactions")
 	errNoAllocationToStake             = errors.New("no allocation to stake")
 	errDuplicateInitiallyStakedAddress = errors.New("duplicate initially staked address")
@@ -46,6 +56,14 @@ var (
 	errFutureStartTime                 = errors.New("startTime cannot be in the future")
 	errInitialStakeDurationTooLow      = errors.New("initial stake duration is too low")
 	errOverridesStandardNetworkConfig  = errors.New("overrides standard network genesis config")
+	errInvalidAssetName                = errors.New("asset name must be 1 to 128 letters, numbers, or inner spaces")
+	errInvalidAssetSymbol              = errors.New("asset symbol must be 1 to 4 upper case letters")
+	errAssetDenominationTooLarge       = errors.New("asset denomination is too large")
+	errDuplicateAssetSymbol            = errors.New("duplicate asset symbol")
+	errAssetSymbolBeforeAVAX           = errors.New("asset symbol must sort after AVAX")
+	errNoAssetHolders                  = errors.New("asset must have initial holders")
+	errNoAssetAmount                   = errors.New("asset holder must hold a positive amount")
+	errUnexpectedSupply                = errors.New("allocations don't sum to the expected supply")
 )
 
 // validateInitialStakedFunds ensures all staked
@@ -177,9 +195,109 @@ func validateConfig(networkID uint32, config *Config, stakingCfg *StakingConfig)
 		return errNoCChainGenesis
 	}
 
+	// The C-Chain chain ID must be defined. Reusing the chain ID of a standard
+	// network is only warned about by the node, as existing networks may
+	// already do so. See [CChainIDInUse].
+	cChainID, err := config.CChainID()
+	if err != nil {
+		return err
+	}
+	if cChainID == nil || cChainID.Sign() <= 0 {
+		return fmt.Errorf("%w: %s", errInvalidCChainID, cChainID)
+	}
+
+	if _, err := config.cChainGenesisWithAllocations(); err != nil {
+		return err
+	}
+
+	if err := validateXChainAssets(config); err != nil {
+		return fmt.Errorf("X-Chain assets validation failed: %w", err)
+	}
+
+	if config.ExpectedSupply != 0 {
+		totalAllocation, err := config.TotalAllocation()
+		if err != nil {
+			return fmt.Errorf("unable to calculate total allocation: %w", err)
+		}
+		if totalAllocation != config.ExpectedSupply {
+			return fmt.Errorf(
+				"%w: allocations sum to %d nAVAX but %d nAVAX are expected",
+				errUnexpectedSupply,
+				totalAllocation,
+				config.ExpectedSupply,
+			)
+		}
+	}
+
 	return nil
 }
 
+// validateXChainAssets ensures that the X-Chain assets are valid
+// CreateAssetTxs.
+//
+// The X-Chain uses the first asset of its genesis, ordered by symbol, to pay
+// fees. So, the symbols must sort after AVAX.
+func validateXChainAssets(config *Config) error {
+	symbols := set.NewSet[string](len(config.XChainAssets))
+	for _, asset := range config.XChainAssets {
+		if !isValidAssetName(asset.Name) {
+			return fmt.Errorf("%w: %q", errInvalidAssetName, asset.Name)
+		}
+		if !isValidAssetSymbol(asset.Symbol) {
+			return fmt.Errorf("%w: %q", errInvalidAssetSymbol, asset.Symbol)
+		}
+		if asset.Denomination > maxAssetDenomination {
+			return fmt.Errorf(
+				"%w: %s has denomination %d > %d",
+				errAssetDenominationTooLarge,
+				asset.Symbol,
+				asset.Denomination,
+				maxAssetDenomination,
+			)
+		}
+		if symbols.Contains(asset.Symbol) {
+			return fmt.Errorf("%w: %s", errDuplicateAssetSymbol, asset.Symbol)
+		}
+		symbols.Add(asset.Symbol)
+		if asset.Symbol <= avaxAlias {
+			return fmt.Errorf("%w: %s", errAssetSymbolBeforeAVAX, asset.Symbol)
+		}
+		if len(asset.InitialHolders) == 0 {
+			return fmt.Errorf("%w: %s", errNoAssetHolders, asset.Symbol)
+		}
+		for _, holder := range asset.InitialHolders {
+			if holder.Amount == 0 {
+				return fmt.Errorf("%w: %s", errNoAssetAmount, asset.Symbol)
+			}
+		}
+	}
+	return nil
+}
+
+func isValidAssetName(name string) bool {
+	if len(name) == 0 || len(name) > maxAssetNameLen || strings.TrimSpace(name) != name {
+		return false
+	}
+	for _, r := range name {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsNumber(r) || r == ' ') {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidAssetSymbol(symbol string) bool {
+	if len(symbol) == 0 || len(symbol) > maxAssetSymbolLen {
+		return false
+	}
+	for _, r := range symbol {
+		if r > unicode.MaxASCII || !unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
 // FromFile returns the genesis data of the Platform Chain.
 //
 // Since an Avalanche network has exactly one Platform Chain, and the Platform
@@ -264,12 +382,42 @@ func FromFlag(networkID uint32, genesisContent string, stakingCfg *StakingConfig
 	return FromConfig(customConfig)
 }
 
+// Args are the arguments that FromConfig passes to the genesis builders of the
+// X-Chain and the P-Chain.
+type Args struct {
+	AVAXAssetID    ids.ID               `json:"avaxAssetID"`
+	AVMArgs        avm.BuildGenesisArgs `json:"avmArgs"`
+	PlatformVMArgs api.BuildGenesisArgs `json:"platformvmArgs"`
+}
+
 // FromConfig returns:
 //
 //  1. The byte representation of the genesis state of the platform chain
 //     (ie the genesis state of the network)
 //  2. The asset ID of AVAX
 func FromConfig(config *Config) ([]byte, ids.ID, error) {
+	args, err := buildArgs(config)
+	if err != nil {
+		return nil, ids.ID{}, err
+	}
+
+	platformvmReply := api.BuildGenesisReply{}
+	platformvmSS := api.StaticService{}
+	if err := platformvmSS.BuildGenesis(nil, &args.PlatformVMArgs, &platformvmReply); err != nil {
+		return nil, ids.ID{}, fmt.Errorf("problem while building platform chain's genesis state: %w", err)
+	}
+
+	genesisBytes, err := formatting.Decode(platformvmReply.Encoding, platformvmReply.Bytes)
+	if err != nil {
+		return nil, ids.ID{}, fmt.Errorf("problem parsing platformvm genesis bytes: %w", err)
+	}
+
+	return genesisBytes, args.AVAXAssetID, nil
+}
+
+// buildArgs builds the X-Chain genesis and returns the arguments to build the
+// P-Chain genesis from [config].
+func buildArgs(config *Config) (*Args, error) {
 	hrp := constants.GetHRP(config.NetworkID)
 
 	amount := uint64(0)
@@ -298,7 +446,7 @@ func FromConfig(config *Config) ([]byte, ids.ID, error) {
 		for _, allocation := range xAllocations {
 			addr, err := address.FormatBech32(hrp, allocation.AVAXAddr.Bytes())
 			if err != nil {
-				return nil, ids.ID{}, err
+				return nil, err
 			}
 
 			avax.InitialState["fixedCap"] = append(avax.InitialState["fixedCap"], avm.Holder{
@@ -312,33 +460,52 @@ func FromConfig(config *Config) ([]byte, ids.ID, error) {
 		var err error
 		avax.Memo, err = formatting.Encode(defaultEncoding, memoBytes)
 		if err != nil {
-			return nil, ids.Empty, fmt.Errorf("couldn't parse memo bytes to string: %w", err)
+			return nil, fmt.Errorf("couldn't parse memo bytes to string: %w", err)
 		}
 		avmArgs.GenesisData = map[string]avm.AssetDefinition{
-			"AVAX": avax, // The AVM starts out with one asset: AVAX
+			avaxAlias: avax, // The AVM starts out with AVAX
 		}
 	}
+	for _, asset := range config.XChainAssets {
+		assetDefinition := avm.AssetDefinition{
+			Name:         asset.Name,
+			Symbol:       asset.Symbol,
+			Denomination: json.Uint8(asset.Denomination),
+			InitialState: map[string][]interface{}{},
+		}
+		for _, holder := range asset.InitialHolders {
+			addr, err := address.FormatBech32(hrp, holder.AVAXAddr.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			assetDefinition.InitialState["fixedCap"] = append(assetDefinition.InitialState["fixedCap"], avm.Holder{
+				Amount:  json.Uint64(holder.Amount),
+				Address: addr,
+			})
+		}
+		avmArgs.GenesisData[asset.Symbol] = assetDefinition
+	}
 	avmReply := avm.BuildGenesisReply{}
 
 	avmSS := avm.CreateStaticService()
 	err := avmSS.BuildGenesis(nil, &avmArgs, &avmReply)
 	if err != nil {
-		return nil, ids.ID{}, err
+		return nil, err
 	}
 
 	bytes, err := formatting.Decode(defaultEncoding, avmReply.Bytes)
 	if err != nil {
-		return nil, ids.ID{}, fmt.Errorf("couldn't parse avm genesis reply: %w", err)
+		return nil, fmt.Errorf("couldn't parse avm genesis reply: %w", err)
 	}
 	avaxAssetID, err := AVAXAssetID(bytes)
 	if err != nil {
-		return nil, ids.ID{}, fmt.Errorf("couldn't generate AVAX asset ID: %w", err)
+		return nil, fmt.Errorf("couldn't generate AVAX asset ID: %w", err)
 	}
 
 	genesisTime := time.Unix(int64(config.StartTime), 0)
 	initialSupply, err := config.InitialSupply()
 	if err != nil {
-		return nil, ids.ID{}, fmt.Errorf("couldn't calculate the initial supply: %w", err)
+		return nil, fmt.Errorf("couldn't calculate the initial supply: %w", err)
 	}
 
 	initiallyStaked := set.Of(config.InitialStakedFunds...)
@@ -360,13 +527,13 @@ func FromConfig(config *Config) ([]byte, ids.ID, error) {
 		}
 		addr, err := address.FormatBech32(hrp, allocation.AVAXAddr.Bytes())
 		if err != nil {
-			return nil, ids.ID{}, err
+			return nil, err
 		}
 		for _, unlock := range allocation.UnlockSchedule {
 			if unlock.Amount > 0 {
 				msgStr, err := formatting.Encode(defaultEncoding, allocation.ETHAddr.Bytes())
 				if err != nil {
-					return nil, ids.Empty, fmt.Errorf("couldn't encode message: %w", err)
+					return nil, fmt.Errorf("couldn't encode message: %w", err)
 				}
 				platformvmArgs.UTXOs = append(platformvmArgs.UTXOs,
 					api.UTXO{
@@ -391,19 +558,19 @@ func FromConfig(config *Config) ([]byte, ids.ID, error) {
 
 		destAddrStr, err := address.FormatBech32(hrp, staker.RewardAddress.Bytes())
 		if err != nil {
-			return nil, ids.ID{}, err
+			return nil, err
 		}
 
 		utxos := []api.UTXO(nil)
 		for _, allocation := range nodeAllocations {
 			addr, err := address.FormatBech32(hrp, allocation.AVAXAddr.Bytes())
 			if err != nil {
-				return nil, ids.ID{}, err
+				return nil, err
 			}
 			for _, unlock := range allocation.UnlockSchedule {
 				msgStr, err := formatting.Encode(defaultEncoding, allocation.ETHAddr.Bytes())
 				if err != nil {
-					return nil, ids.Empty, fmt.Errorf("couldn't encode message: %w", err)
+					return nil, fmt.Errorf("couldn't encode message: %w", err)
 				}
 				utxos = append(utxos, api.UTXO{
 					Locktime: json.Uint64(unlock.Locktime),
@@ -436,9 +603,13 @@ func FromConfig(config *Config) ([]byte, ids.ID, error) {
 	}
 
 	// Specify the chains that exist upon this network's creation
-	genesisStr, err := formatting.Encode(defaultEncoding, []byte(config.CChainGenesis))
+	cChainGenesis, err := config.cChainGenesisWithAllocations()
 	if err != nil {
-		return nil, ids.Empty, fmt.Errorf("couldn't encode message: %w", err)
+		return nil, fmt.Errorf("couldn't add C-Chain allocations: %w", err)
+	}
+	genesisStr, err := formatting.Encode(defaultEncoding, []byte(cChainGenesis))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't encode message: %w", err)
 	}
 	platformvmArgs.Chains = []api.Chain{
 		{
@@ -460,18 +631,11 @@ func FromConfig(config *Config) ([]byte, ids.ID, error) {
 		},
 	}
 
-	platformvmReply := api.BuildGenesisReply{}
-	platformvmSS := api.StaticService{}
-	if err := platformvmSS.BuildGenesis(nil, &platformvmArgs, &platformvmReply); err != nil {
-		return nil, ids.ID{}, fmt.Errorf("problem while building platform chain's genesis state: %w", err)
-	}
-
-	genesisBytes, err := formatting.Decode(platformvmReply.Encoding, platformvmReply.Bytes)
-	if err != nil {
-		return nil, ids.ID{}, fmt.Errorf("problem parsing platformvm genesis bytes: %w", err)
-	}
-
-	return genesisBytes, avaxAssetID, nil
+	return &Args{
+		AVAXAssetID:    avaxAssetID,
+		AVMArgs:        avmArgs,
+		PlatformVMArgs: platformvmArgs,
+	}, nil
 }
 
 func splitAllocations(allocations []Allocation, numSplits int) [][]Allocation {
@@ -551,6 +715,38 @@ func VMGenesis(genesisBytes []byte, vmID ids.ID) (*pchaintxs.Tx, error) {
 	return nil, fmt.Errorf("couldn't find blockchain with VM ID %s", vmID)
 }
 
+// CChainIDInUse returns the ID of the standard network, other than
+// [networkID], whose C-Chain uses the same chain ID as the C-Chain defined in
+// [genesisBytes]. The returned bool is false if there is no such network.
+//
+// The C-Chain chain ID is included in the signature of EVM txs. Sharing a
+// chain ID with another network allows txs to be replayed across the networks.
+func CChainIDInUse(networkID uint32, genesisBytes []byte) (uint32, bool, error) {
+	createEVMTx, err := VMGenesis(genesisBytes, constants.EVMID)
+	if err != nil {
+		return 0, false, err
+	}
+	cChainID, err := parseCChainID(createEVMTx.Unsigned.(*pchaintxs.CreateChainTx).GenesisData)
+	if err != nil || cChainID == nil {
+		return 0, false, err
+	}
+
+	for _, standardConfig := range []*Config{&MainnetConfig, &FujiConfig} {
+		if networkID == standardConfig.NetworkID {
+			continue
+		}
+
+		standardCChainID, err := standardConfig.CChainID()
+		if err != nil {
+			return 0, false, err
+		}
+		if cChainID.Cmp(standardCChainID) == 0 {
+			return standardConfig.NetworkID, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
 func AVAXAssetID(avmGenesisBytes []byte) (ids.ID, error) {
 	parser, err := xchaintxs.NewParser(
 		[]fxs.Fx{
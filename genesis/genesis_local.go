@@ -56,6 +56,8 @@ var (
 			MinDelegationFee:  20000, // 2%
 			MinStakeDuration:  24 * time.Hour,
 			MaxStakeDuration:  365 * 24 * time.Hour,
+			// The grace period only applies once the E upgrade is activated
+			SubnetValidatorRemovalGracePeriod: 0,
 			RewardConfig: reward.Config{
 				MaxConsumptionRate: .12 * reward.PercentDenominator,
 				MinConsumptionRate: .10 * reward.PercentDenominator,
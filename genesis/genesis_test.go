@@ -21,12 +21,21 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	xchaintxs "github.com/ava-labs/avalanchego/vms/avm/txs"
 )
 
 var (
 	//go:embed genesis_test.json
-	customGenesisConfigJSON  []byte
+	customGenesisConfigJSON []byte
+	//go:embed genesis_hashes.json
+	pinnedGenesisHashesJSON []byte
+
 	invalidGenesisConfigJSON = []byte(`{
 		"networkID": 9999}}}}
 	}`)
@@ -152,6 +161,146 @@ func TestValidateConfig(t *testing.T) {
 			}(),
 			expectedErr: errNoCChainGenesis,
 		},
+		"invalid C-Chain genesis": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.CChainGenesis = "{"
+				return &thisConfig
+			}(),
+			expectedErr: errInvalidCChainGenesis,
+		},
+		"missing C-Chain chain ID": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.CChainGenesis = `{"config":{}}`
+				return &thisConfig
+			}(),
+			expectedErr: errInvalidCChainID,
+		},
+		"zero C-Chain chain ID": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.CChainGenesis = `{"config":{"chainId":0}}`
+				return &thisConfig
+			}(),
+			expectedErr: errInvalidCChainID,
+		},
+		"mainnet C-Chain chain ID": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.CChainGenesis = MainnetConfig.CChainGenesis
+				return &thisConfig
+			}(),
+			expectedErr: nil,
+		},
+		"custom C-Chain chain ID": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.CChainGenesis = `{"config":{"chainId":99999}}`
+				return &thisConfig
+			}(),
+			expectedErr: nil,
+		},
+		"valid X-Chain asset": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.XChainAssets = []XChainAsset{testXChainAsset()}
+				return &thisConfig
+			}(),
+			expectedErr: nil,
+		},
+		"invalid X-Chain asset name": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				asset := testXChainAsset()
+				asset.Name = " Test Coin"
+				thisConfig.XChainAssets = []XChainAsset{asset}
+				return &thisConfig
+			}(),
+			expectedErr: errInvalidAssetName,
+		},
+		"invalid X-Chain asset symbol": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				asset := testXChainAsset()
+				asset.Symbol = "test"
+				thisConfig.XChainAssets = []XChainAsset{asset}
+				return &thisConfig
+			}(),
+			expectedErr: errInvalidAssetSymbol,
+		},
+		"X-Chain asset denomination too large": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				asset := testXChainAsset()
+				asset.Denomination = maxAssetDenomination + 1
+				thisConfig.XChainAssets = []XChainAsset{asset}
+				return &thisConfig
+			}(),
+			expectedErr: errAssetDenominationTooLarge,
+		},
+		"X-Chain asset symbol before AVAX": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				asset := testXChainAsset()
+				asset.Symbol = "ABC"
+				thisConfig.XChainAssets = []XChainAsset{asset}
+				return &thisConfig
+			}(),
+			expectedErr: errAssetSymbolBeforeAVAX,
+		},
+		"duplicate X-Chain asset symbol": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.XChainAssets = []XChainAsset{testXChainAsset(), testXChainAsset()}
+				return &thisConfig
+			}(),
+			expectedErr: errDuplicateAssetSymbol,
+		},
+		"X-Chain asset without holders": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				asset := testXChainAsset()
+				asset.InitialHolders = nil
+				thisConfig.XChainAssets = []XChainAsset{asset}
+				return &thisConfig
+			}(),
+			expectedErr: errNoAssetHolders,
+		},
+		"expected supply": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				totalAllocation, err := thisConfig.TotalAllocation()
+				if err != nil {
+					panic(err)
+				}
+				thisConfig.ExpectedSupply = totalAllocation
+				return &thisConfig
+			}(),
+			expectedErr: nil,
+		},
+		"unexpected supply": {
+			networkID: 12345,
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.ExpectedSupply = 1
+				return &thisConfig
+			}(),
+			expectedErr: errUnexpectedSupply,
+		},
 		"empty message": {
 			networkID: 12345,
 			config: func() *Config {
@@ -373,6 +522,68 @@ func TestGenesis(t *testing.T) {
 	}
 }
 
+// TestGenesisHashes ensures that the genesis of the standard networks doesn't
+// change unintentionally, for example because of a change to the genesis
+// builder of a VM.
+func TestGenesisHashes(t *testing.T) {
+	var pinnedHashes map[string]Hashes
+	require.NoError(t, json.Unmarshal(pinnedGenesisHashesJSON, &pinnedHashes))
+
+	for _, networkID := range []uint32{constants.MainnetID, constants.FujiID, constants.LocalID} {
+		networkName := constants.NetworkIDToNetworkName[networkID]
+		t.Run(networkName, func(t *testing.T) {
+			require := require.New(t)
+
+			require.Contains(pinnedHashes, networkName)
+
+			hashes, err := ComputeHashes(networkID)
+			require.NoError(err)
+			require.Equal(
+				pinnedHashes[networkName],
+				hashes,
+				"the genesis changed; if this is intended, update the pinned hashes by running: cd genesis && go run ./generate/hashes",
+			)
+		})
+	}
+}
+
+func TestGenesisXChainAssets(t *testing.T) {
+	require := require.New(t)
+
+	config := LocalConfig
+	_, expectedAVAXAssetID, err := FromConfig(&config)
+	require.NoError(err)
+
+	config.XChainAssets = []XChainAsset{testXChainAsset()}
+	genesisBytes, avaxAssetID, err := FromConfig(&config)
+	require.NoError(err)
+
+	// AVAX remains the first asset of the X-Chain genesis
+	require.Equal(expectedAVAXAssetID, avaxAssetID)
+
+	xChainTx, err := VMGenesis(genesisBytes, constants.AVMID)
+	require.NoError(err)
+	xChainGenesisBytes := xChainTx.Unsigned.(*txs.CreateChainTx).GenesisData
+
+	parser, err := xchaintxs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+	})
+	require.NoError(err)
+	xChainGenesis := avm.Genesis{}
+	_, err = parser.GenesisCodec().Unmarshal(xChainGenesisBytes, &xChainGenesis)
+	require.NoError(err)
+
+	require.Len(xChainGenesis.Txs, 2)
+	require.Equal(avaxAlias, xChainGenesis.Txs[0].Alias)
+	asset := xChainGenesis.Txs[1]
+	require.Equal("TEST", asset.Alias)
+	require.Equal("Test Coin", asset.Name)
+	require.Equal(byte(6), asset.Denomination)
+	require.Len(asset.States, 1)
+	require.Len(asset.States[0].Outs, 1)
+	require.Equal(uint64(1000), asset.States[0].Outs[0].(*secp256k1fx.TransferOutput).Amt)
+}
+
 func TestVMGenesis(t *testing.T) {
 	type vmTest struct {
 		vmID       ids.ID
@@ -451,6 +662,64 @@ func TestVMGenesis(t *testing.T) {
 	}
 }
 
+func TestCChainIDInUse(t *testing.T) {
+	tests := []struct {
+		name                      string
+		config                    func() *Config
+		expectedStandardNetworkID uint32
+		expectedInUse             bool
+	}{
+		{
+			name: "mainnet",
+			config: func() *Config {
+				return &MainnetConfig
+			},
+			expectedInUse: false,
+		},
+		{
+			name: "local",
+			config: func() *Config {
+				return &LocalConfig
+			},
+			expectedInUse: false,
+		},
+		{
+			name: "custom network with the mainnet C-Chain chain ID",
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.CChainGenesis = MainnetConfig.CChainGenesis
+				return &thisConfig
+			},
+			expectedStandardNetworkID: constants.MainnetID,
+			expectedInUse:             true,
+		},
+		{
+			name: "custom network with the fuji C-Chain chain ID",
+			config: func() *Config {
+				thisConfig := LocalConfig
+				thisConfig.CChainGenesis = FujiConfig.CChainGenesis
+				return &thisConfig
+			},
+			expectedStandardNetworkID: constants.FujiID,
+			expectedInUse:             true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := test.config()
+			genesisBytes, _, err := FromConfig(config)
+			require.NoError(err)
+
+			standardNetworkID, inUse, err := CChainIDInUse(config.NetworkID, genesisBytes)
+			require.NoError(err)
+			require.Equal(test.expectedInUse, inUse)
+			require.Equal(test.expectedStandardNetworkID, standardNetworkID)
+		})
+	}
+}
+
 func TestAVAXAssetID(t *testing.T) {
 	tests := []struct {
 		networkID  uint32
@@ -487,3 +756,17 @@ func TestAVAXAssetID(t *testing.T) {
 		})
 	}
 }
+
+func testXChainAsset() XChainAsset {
+	return XChainAsset{
+		Name:         "Test Coin",
+		Symbol:       "TEST",
+		Denomination: 6,
+		InitialHolders: []XChainHolder{
+			{
+				AVAXAddr: LocalConfig.InitialStakedFunds[0],
+				Amount:   1000,
+			},
+		},
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+// Hashes are the IDs derived from the genesis of a network. Any change to them
+// changes the genesis of the network, which breaks consensus with the nodes
+// that run the previous genesis.
+type Hashes struct {
+	GenesisID   ids.ID `json:"genesisID"`
+	AVAXAssetID ids.ID `json:"avaxAssetID"`
+	XChainID    ids.ID `json:"xChainID"`
+	CChainID    ids.ID `json:"cChainID"`
+}
+
+// ComputeHashes returns the hashes of the predefined genesis of [networkID].
+//
+// The hashes of the standard networks are pinned in genesis_hashes.json to
+// detect unintended changes of their genesis. If a change is intended, the
+// pinned hashes can be updated by running:
+//
+//	cd genesis && go run ./generate/hashes
+func ComputeHashes(networkID uint32) (Hashes, error) {
+	genesisBytes, avaxAssetID, err := FromConfig(GetConfig(networkID))
+	if err != nil {
+		return Hashes{}, fmt.Errorf("failed to build genesis: %w", err)
+	}
+
+	xChainTx, err := VMGenesis(genesisBytes, constants.AVMID)
+	if err != nil {
+		return Hashes{}, err
+	}
+	cChainTx, err := VMGenesis(genesisBytes, constants.EVMID)
+	if err != nil {
+		return Hashes{}, err
+	}
+
+	return Hashes{
+		GenesisID:   hashing.ComputeHash256Array(genesisBytes),
+		AVAXAssetID: avaxAssetID,
+		XChainID:    xChainTx.ID(),
+		CChainID:    cChainTx.ID(),
+	}, nil
+}
@@ -30,6 +30,10 @@ type StakingConfig struct {
 	// MaxStakeDuration is the maximum amount of time a validator can validate
 	// for in a single period.
 	MaxStakeDuration time.Duration `json:"maxStakeDuration"`
+	// SubnetValidatorRemovalGracePeriod is the amount of time after a
+	// permissioned subnet validator's end time before it is removed from the
+	// current validator set. It only applies once the E upgrade is activated.
+	SubnetValidatorRemovalGracePeriod time.Duration `json:"subnetValidatorRemovalGracePeriod"`
 	// RewardConfig is the config for the reward function.
 	RewardConfig reward.Config `json:"rewardConfig"`
 }
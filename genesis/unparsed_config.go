@@ -6,13 +6,18 @@ package genesis
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 )
 
-var errInvalidETHAddress = errors.New("invalid eth address")
+var (
+	errInvalidETHAddress    = errors.New("invalid eth address")
+	errInvalidCChainBalance = errors.New("invalid C-Chain balance")
+)
 
 type UnparsedAllocation struct {
 	ETHAddr        string         `json:"ethAddr"`
@@ -27,15 +32,7 @@ func (ua UnparsedAllocation) Parse() (Allocation, error) {
 		UnlockSchedule: ua.UnlockSchedule,
 	}
 
-	if len(ua.ETHAddr) < 2 {
-		return a, errInvalidETHAddress
-	}
-
-	ethAddrBytes, err := hex.DecodeString(ua.ETHAddr[2:])
-	if err != nil {
-		return a, err
-	}
-	ethAddr, err := ids.ToShortID(ethAddrBytes)
+	ethAddr, err := parseETHAddr(ua.ETHAddr)
 	if err != nil {
 		return a, err
 	}
@@ -54,6 +51,82 @@ func (ua UnparsedAllocation) Parse() (Allocation, error) {
 	return a, nil
 }
 
+type UnparsedCChainAllocation struct {
+	ETHAddr string `json:"ethAddr"`
+	// Balance in wei, either in decimal or in hex prefixed with 0x
+	Balance string `json:"balance"`
+}
+
+func (ua UnparsedCChainAllocation) Parse() (CChainAllocation, error) {
+	ethAddr, err := parseETHAddr(ua.ETHAddr)
+	if err != nil {
+		return CChainAllocation{}, err
+	}
+
+	balance, ok := new(big.Int).SetString(ua.Balance, 0)
+	if !ok || balance.Sign() < 0 {
+		return CChainAllocation{}, fmt.Errorf("%w: %q", errInvalidCChainBalance, ua.Balance)
+	}
+	return CChainAllocation{
+		ETHAddr: ethAddr,
+		Balance: balance,
+	}, nil
+}
+
+func parseETHAddr(addrStr string) (ids.ShortID, error) {
+	if len(addrStr) < 2 {
+		return ids.ShortID{}, errInvalidETHAddress
+	}
+
+	ethAddrBytes, err := hex.DecodeString(addrStr[2:])
+	if err != nil {
+		return ids.ShortID{}, err
+	}
+	return ids.ToShortID(ethAddrBytes)
+}
+
+type UnparsedXChainHolder struct {
+	AVAXAddr string `json:"avaxAddr"`
+	Amount   uint64 `json:"amount"`
+}
+
+func (uh UnparsedXChainHolder) Parse() (XChainHolder, error) {
+	h := XChainHolder{
+		Amount: uh.Amount,
+	}
+
+	_, _, avaxAddrBytes, err := address.Parse(uh.AVAXAddr)
+	if err != nil {
+		return h, err
+	}
+	h.AVAXAddr, err = ids.ToShortID(avaxAddrBytes)
+	return h, err
+}
+
+type UnparsedXChainAsset struct {
+	Name           string                 `json:"name"`
+	Symbol         string                 `json:"symbol"`
+	Denomination   uint8                  `json:"denomination"`
+	InitialHolders []UnparsedXChainHolder `json:"initialHolders"`
+}
+
+func (ua UnparsedXChainAsset) Parse() (XChainAsset, error) {
+	a := XChainAsset{
+		Name:           ua.Name,
+		Symbol:         ua.Symbol,
+		Denomination:   ua.Denomination,
+		InitialHolders: make([]XChainHolder, len(ua.InitialHolders)),
+	}
+	for i, uh := range ua.InitialHolders {
+		h, err := uh.Parse()
+		if err != nil {
+			return a, err
+		}
+		a.InitialHolders[i] = h
+	}
+	return a, nil
+}
+
 type UnparsedStaker struct {
 	NodeID        ids.NodeID                `json:"nodeID"`
 	RewardAddress string                    `json:"rewardAddress"`
@@ -92,9 +165,14 @@ type UnparsedConfig struct {
 	InitialStakedFunds         []string         `json:"initialStakedFunds"`
 	InitialStakers             []UnparsedStaker `json:"initialStakers"`
 
-	CChainGenesis string `json:"cChainGenesis"`
+	CChainGenesis     string                     `json:"cChainGenesis"`
+	CChainAllocations []UnparsedCChainAllocation `json:"cChainAllocations,omitempty"`
+
+	XChainAssets []UnparsedXChainAsset `json:"xChainAssets,omitempty"`
 
 	Message string `json:"message"`
+
+	ExpectedSupply uint64 `json:"expectedSupply,omitempty"`
 }
 
 func (uc UnparsedConfig) Parse() (Config, error) {
@@ -108,6 +186,7 @@ func (uc UnparsedConfig) Parse() (Config, error) {
 		InitialStakers:             make([]Staker, len(uc.InitialStakers)),
 		CChainGenesis:              uc.CChainGenesis,
 		Message:                    uc.Message,
+		ExpectedSupply:             uc.ExpectedSupply,
 	}
 	for i, ua := range uc.Allocations {
 		a, err := ua.Parse()
@@ -134,5 +213,19 @@ func (uc UnparsedConfig) Parse() (Config, error) {
 		}
 		c.InitialStakers[i] = is
 	}
+	for _, ua := range uc.CChainAllocations {
+		a, err := ua.Parse()
+		if err != nil {
+			return c, err
+		}
+		c.CChainAllocations = append(c.CChainAllocations, a)
+	}
+	for _, ua := range uc.XChainAssets {
+		a, err := ua.Parse()
+		if err != nil {
+			return c, err
+		}
+		c.XChainAssets = append(c.XChainAssets, a)
+	}
 	return c, nil
 }
@@ -30,6 +30,7 @@ func NewCreator(
 	metrics prometheus.Registerer,
 	parentNamespace string,
 	compressionType compression.Type,
+	compressionThreshold int,
 	maxMessageTimeout time.Duration,
 ) (Creator, error) {
 	namespace := metric.AppendNamespace(parentNamespace, "codec")
@@ -37,6 +38,7 @@ func NewCreator(
 		log,
 		namespace,
 		metrics,
+		compressionThreshold,
 		maxMessageTimeout,
 	)
 	if err != nil {
@@ -25,6 +25,7 @@ func Test_newMsgBuilder(t *testing.T) {
 		logging.NoLog{},
 		"test",
 		prometheus.NewRegistry(),
+		0,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -395,6 +396,7 @@ func TestAppError(t *testing.T) {
 		logging.NoLog{},
 		"",
 		prometheus.NewRegistry(),
+		0,
 		time.Second,
 	)
 	require.NoError(err)
@@ -143,14 +143,21 @@ type msgBuilder struct {
 	zstdCompressor compression.Compressor
 	count          *prometheus.CounterVec // type + op + direction
 	duration       *prometheus.GaugeVec   // type + op + direction
-
-	maxMessageTimeout time.Duration
+	// The ratio of [compressedBytes] to [uncompressedBytes] is the
+	// compression ratio.
+	uncompressedBytes *prometheus.CounterVec // type + op + direction
+	compressedBytes   *prometheus.CounterVec // type + op + direction
+
+	// Messages smaller than [compressionThreshold] bytes aren't compressed.
+	compressionThreshold int
+	maxMessageTimeout    time.Duration
 }
 
 func newMsgBuilder(
 	log logging.Logger,
 	namespace string,
 	metrics prometheus.Registerer,
+	compressionThreshold int,
 	maxMessageTimeout time.Duration,
 ) (*msgBuilder, error) {
 	zstdCompressor, err := compression.NewZstdCompressor(constants.DefaultMaxMessageSize)
@@ -178,12 +185,31 @@ func newMsgBuilder(
 			},
 			metricLabels,
 		),
+		uncompressedBytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "compressed_uncompressed_bytes",
+				Help:      "number of bytes of compressed messages before compression",
+			},
+			metricLabels,
+		),
+		compressedBytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "compressed_bytes",
+				Help:      "number of bytes of compressed messages after compression",
+			},
+			metricLabels,
+		),
 
-		maxMessageTimeout: maxMessageTimeout,
+		compressionThreshold: compressionThreshold,
+		maxMessageTimeout:    maxMessageTimeout,
 	}
 	return mb, utils.Err(
 		metrics.Register(mb.count),
 		metrics.Register(mb.duration),
+		metrics.Register(mb.uncompressedBytes),
+		metrics.Register(mb.compressedBytes),
 	)
 }
 
@@ -201,6 +227,13 @@ func (mb *msgBuilder) marshal(
 		return nil, 0, 0, err
 	}
 
+	// Compressing small messages isn't worth the CPU cost and may even
+	// increase their size. The parser handles uncompressed messages regardless
+	// of the compression type.
+	if len(uncompressedMsgBytes) < mb.compressionThreshold {
+		return uncompressedMsgBytes, 0, op, nil
+	}
+
 	// If compression is enabled, we marshal twice:
 	// 1. the original message
 	// 2. the message with compressed bytes
@@ -241,6 +274,8 @@ func (mb *msgBuilder) marshal(
 	}
 	mb.count.With(labels).Inc()
 	mb.duration.With(labels).Add(float64(compressTook))
+	mb.uncompressedBytes.With(labels).Add(float64(len(uncompressedMsgBytes)))
+	mb.compressedBytes.With(labels).Add(float64(len(compressedMsgBytes)))
 
 	bytesSaved := len(uncompressedMsgBytes) - len(compressedMsgBytes)
 	return compressedMsgBytes, bytesSaved, op, nil
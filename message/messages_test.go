@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
@@ -27,6 +28,7 @@ func TestMessage(t *testing.T) {
 		logging.NoLog{},
 		"test",
 		prometheus.NewRegistry(),
+		0,
 		5*time.Second,
 	)
 	require.NoError(t, err)
@@ -660,6 +662,46 @@ func TestMessage(t *testing.T) {
 	}
 }
 
+func TestMarshalCompressionBytes(t *testing.T) {
+	require := require.New(t)
+
+	mb, err := newMsgBuilder(
+		logging.NoLog{},
+		"test",
+		prometheus.NewRegistry(),
+		0,
+		5*time.Second,
+	)
+	require.NoError(err)
+
+	testID := ids.GenerateTestID()
+	msg := &p2p.Message{
+		Message: &p2p.Message_AppGossip{
+			AppGossip: &p2p.AppGossip{
+				ChainId:  testID[:],
+				AppBytes: bytes.Repeat([]byte{0}, 100),
+			},
+		},
+	}
+	uncompressedMsgBytes, err := proto.Marshal(msg)
+	require.NoError(err)
+
+	// Uncompressed messages aren't recorded
+	_, _, _, err = mb.marshal(msg, compression.TypeNone)
+	require.NoError(err)
+
+	compressedMsgBytes, _, _, err := mb.marshal(msg, compression.TypeZstd)
+	require.NoError(err)
+
+	labels := prometheus.Labels{
+		typeLabel:      compression.TypeZstd.String(),
+		opLabel:        AppGossipOp.String(),
+		directionLabel: compressionLabel,
+	}
+	require.Equal(float64(len(uncompressedMsgBytes)), testutil.ToFloat64(mb.uncompressedBytes.With(labels)))
+	require.Equal(float64(len(compressedMsgBytes)), testutil.ToFloat64(mb.compressedBytes.With(labels)))
+}
+
 // Tests the Stringer interface on inbound messages
 func TestInboundMessageToString(t *testing.T) {
 	t.Parallel()
@@ -670,6 +712,7 @@ func TestInboundMessageToString(t *testing.T) {
 		logging.NoLog{},
 		"test",
 		prometheus.NewRegistry(),
+		0,
 		5*time.Second,
 	)
 	require.NoError(err)
@@ -701,6 +744,7 @@ func TestEmptyInboundMessage(t *testing.T) {
 		logging.NoLog{},
 		"test",
 		prometheus.NewRegistry(),
+		0,
 		5*time.Second,
 	)
 	require.NoError(err)
@@ -722,6 +766,7 @@ func TestNilInboundMessage(t *testing.T) {
 		logging.NoLog{},
 		"test",
 		prometheus.NewRegistry(),
+		0,
 		5*time.Second,
 	)
 	require.NoError(err)
@@ -741,3 +786,56 @@ func TestNilInboundMessage(t *testing.T) {
 	pingMsg := parsedMsg.message.(*p2p.Ping)
 	require.NotNil(pingMsg)
 }
+
+func TestMarshalCompressionThreshold(t *testing.T) {
+	require := require.New(t)
+
+	testID := ids.GenerateTestID()
+	msg := &p2p.Message{
+		Message: &p2p.Message_AppGossip{
+			AppGossip: &p2p.AppGossip{
+				ChainId:  testID[:],
+				AppBytes: bytes.Repeat([]byte{0}, 100),
+			},
+		},
+	}
+	uncompressedMsgBytes, err := proto.Marshal(msg)
+	require.NoError(err)
+
+	// Messages below the threshold aren't compressed
+	mb, err := newMsgBuilder(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		len(uncompressedMsgBytes)+1,
+		5*time.Second,
+	)
+	require.NoError(err)
+
+	msgBytes, bytesSaved, _, err := mb.marshal(msg, compression.TypeZstd)
+	require.NoError(err)
+	require.Equal(uncompressedMsgBytes, msgBytes)
+	require.Zero(bytesSaved)
+
+	parsedMsg, _, _, err := mb.unmarshal(msgBytes)
+	require.NoError(err)
+	require.True(proto.Equal(msg, parsedMsg))
+
+	// Messages at the threshold are compressed
+	mb, err = newMsgBuilder(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		len(uncompressedMsgBytes),
+		5*time.Second,
+	)
+	require.NoError(err)
+
+	msgBytes, bytesSaved, _, err = mb.marshal(msg, compression.TypeZstd)
+	require.NoError(err)
+	require.Positive(bytesSaved)
+
+	parsedMsg, _, _, err = mb.unmarshal(msgBytes)
+	require.NoError(err)
+	require.True(proto.Equal(msg, parsedMsg))
+}
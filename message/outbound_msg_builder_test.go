@@ -22,6 +22,7 @@ func Test_newOutboundBuilder(t *testing.T) {
 		logging.NoLog{},
 		"test",
 		prometheus.NewRegistry(),
+		0,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -65,6 +65,12 @@ type PeerListGossipConfig struct {
 	// PeerListBloomResetFreq is how frequently this node will recalculate the
 	// IP tracker's bloom filter.
 	PeerListBloomResetFreq time.Duration `json:"peerListBloomResetFreq"`
+
+	// PeerListAdaptiveGossip scales the number of gossiped validator IPs and
+	// the pull gossip frequency with the number of connected peers. When
+	// enabled, PeerListNumValidatorIPs and PeerListPullGossipFreq are the most
+	// aggressive values that will be used.
+	PeerListAdaptiveGossip bool `json:"peerListAdaptiveGossip"`
 }
 
 type TimeoutConfig struct {
@@ -75,6 +81,11 @@ type TimeoutConfig struct {
 	// ReadHandshakeTimeout is the maximum amount of time to wait for the peer's
 	// connection upgrade to finish before starting the p2p handshake.
 	ReadHandshakeTimeout time.Duration `json:"readHandshakeTimeout"`
+
+	// PeerHandshakeTimeout is the maximum amount of time to wait for the p2p
+	// handshake with a peer to finish after its connection was upgraded. If 0,
+	// the p2p handshake doesn't time out.
+	PeerHandshakeTimeout time.Duration `json:"peerHandshakeTimeout"`
 }
 
 type DelayConfig struct {
@@ -125,6 +136,10 @@ type Config struct {
 	// Assumes all peers support this compression type.
 	CompressionType compression.Type `json:"compressionType"`
 
+	// Outbound messages smaller than this many bytes are sent uncompressed,
+	// regardless of [CompressionType].
+	CompressionThreshold int `json:"compressionThreshold"`
+
 	// TLSKey is this node's TLS key that is used to sign IPs.
 	TLSKey crypto.Signer `json:"-"`
 	// BLSKey is this node's BLS key that is used to sign IPs.
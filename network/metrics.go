@@ -32,11 +32,15 @@ type metrics struct {
 	inboundConnAllowed              prometheus.Counter
 	tlsConnRejected                 prometheus.Counter
 	numUselessPeerListBytes         prometheus.Counter
+	ipCollisions                    prometheus.Counter
+	handshakeTimeouts               prometheus.Counter
 	nodeUptimeWeightedAverage       prometheus.Gauge
 	nodeUptimeRewardingStake        prometheus.Gauge
 	nodeSubnetUptimeWeightedAverage *prometheus.GaugeVec
 	nodeSubnetUptimeRewardingStake  *prometheus.GaugeVec
 	peerConnectedLifetimeAverage    prometheus.Gauge
+	peerListNumValidatorIPs         prometheus.Gauge
+	peerListPullGossipFreq          prometheus.Gauge
 
 	lock                       sync.RWMutex
 	peerConnectedStartTimes    map[ids.NodeID]float64
@@ -113,6 +117,16 @@ func newMetrics(
 			Name:      "num_useless_peerlist_bytes",
 			Help:      "Amount of useless bytes (i.e. information about nodes we already knew/don't want to connect to) received in PeerList messages",
 		}),
+		ipCollisions: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "ip_collisions",
+			Help:      "Number of times a peer connected claiming the same IP as an already connected peer",
+		}),
+		handshakeTimeouts: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "handshake_timeouts",
+			Help:      "Number of connections closed because the peer didn't finish the p2p handshake in time",
+		}),
 		inboundConnRateLimited: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
 			Name:      "inbound_conn_throttler_rate_limited",
@@ -151,6 +165,16 @@ func newMetrics(
 				Help:      "The average duration of all peer connections in nanoseconds",
 			},
 		),
+		peerListNumValidatorIPs: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "peer_list_num_validator_ips",
+			Help:      "Number of validator IPs currently gossiped in response to a peer list request",
+		}),
+		peerListPullGossipFreq: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "peer_list_pull_gossip_frequency",
+			Help:      "Time (in ns) currently waited between peer list requests",
+		}),
 		peerConnectedStartTimes: make(map[ids.NodeID]float64),
 	}
 
@@ -167,12 +191,16 @@ func newMetrics(
 		registerer.Register(m.inboundConnAllowed),
 		registerer.Register(m.tlsConnRejected),
 		registerer.Register(m.numUselessPeerListBytes),
+		registerer.Register(m.ipCollisions),
+		registerer.Register(m.handshakeTimeouts),
 		registerer.Register(m.inboundConnRateLimited),
 		registerer.Register(m.nodeUptimeWeightedAverage),
 		registerer.Register(m.nodeUptimeRewardingStake),
 		registerer.Register(m.nodeSubnetUptimeWeightedAverage),
 		registerer.Register(m.nodeSubnetUptimeRewardingStake),
 		registerer.Register(m.peerConnectedLifetimeAverage),
+		registerer.Register(m.peerListNumValidatorIPs),
+		registerer.Register(m.peerListPullGossipFreq),
 	)
 
 	// init subnet tracker metrics with tracked subnets
@@ -17,6 +17,7 @@ import (
 	"github.com/pires/go-proxyproto"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/ava-labs/avalanchego/api/health"
 	"github.com/ava-labs/avalanchego/genesis"
@@ -45,6 +46,10 @@ const (
 	TimeSinceLastMsgReceivedKey = "timeSinceLastMsgReceived"
 	TimeSinceLastMsgSentKey     = "timeSinceLastMsgSent"
 	SendFailRateKey             = "sendFailRate"
+
+	// ipCollisionLogFrequency is the minimum time between IP collisions logged
+	// at the info level. Other collisions are logged at the debug level.
+	ipCollisionLogFrequency = time.Minute
 )
 
 var (
@@ -150,7 +155,13 @@ type network struct {
 	trackedIPs      map[ids.NodeID]*trackedIP
 	connectingPeers peer.Set
 	connectedPeers  peer.Set
-	closing         bool
+	// connectedIPs maps the IP claimed by each connected peer to its nodeID.
+	// It is used to detect multiple nodeIDs claiming the same IP.
+	connectedIPs map[string]ids.NodeID
+	// ipCollisionLogLimiter limits the rate of IP collision logs, as the
+	// claimed IP is chosen by the peer.
+	ipCollisionLogLimiter *rate.Limiter
+	closing               bool
 
 	// router is notified about all peer [Connected] and [Disconnected] events
 	// as well as all non-handshake peer messages.
@@ -303,7 +314,10 @@ func NewNetwork(
 		ipTracker:       ipTracker,
 		connectingPeers: peer.NewSet(),
 		connectedPeers:  peer.NewSet(),
+		connectedIPs:    make(map[string]ids.NodeID),
 		router:          router,
+
+		ipCollisionLogLimiter: rate.NewLimiter(rate.Every(ipCollisionLogFrequency), 1),
 	}
 	n.peerConfig.Network = n
 	return n, nil
@@ -444,10 +458,12 @@ func (n *network) Connected(nodeID ids.NodeID) {
 		delete(n.trackedIPs, nodeID)
 	}
 	n.connectingPeers.Remove(nodeID)
+
+	peerIP := peer.IP()
+	n.claimIP(nodeID, peerIP.IPPort)
 	n.connectedPeers.Add(peer)
 	n.peersLock.Unlock()
 
-	peerIP := peer.IP()
 	newIP := ips.NewClaimedIPPort(
 		peer.Cert(),
 		peerIP.IPPort,
@@ -469,6 +485,41 @@ func (n *network) Connected(nodeID ids.NodeID) {
 	}
 }
 
+// claimIP records that [nodeID] is connected and claims [ip]. If another
+// connected peer already claims [ip], the collision is counted and logged.
+// Logs are rate limited so that peers can't flood them. Neither peer is
+// disconnected, as the claimed IP is chosen by the peer and could otherwise be
+// used to evict an honest peer.
+//
+// Assumes [n.peersLock] is held.
+func (n *network) claimIP(nodeID ids.NodeID, ip ips.IPPort) {
+	if ip.IsZero() {
+		return
+	}
+
+	ipStr := ip.String()
+	existingNodeID, ok := n.connectedIPs[ipStr]
+	if !ok {
+		n.connectedIPs[ipStr] = nodeID
+		return
+	}
+	if _, ok := n.connectedPeers.GetByID(existingNodeID); !ok {
+		n.connectedIPs[ipStr] = nodeID
+		return
+	}
+
+	n.metrics.ipCollisions.Inc()
+	log := n.peerConfig.Log.Debug
+	if n.ipCollisionLogLimiter.Allow() {
+		log = n.peerConfig.Log.Info
+	}
+	log("multiple peers claim the same IP",
+		zap.Stringer("ip", ip),
+		zap.Stringer("connectedNodeID", existingNodeID),
+		zap.Stringer("nodeID", nodeID),
+	)
+}
+
 // AllowConnection returns true if this node should have a connection to the
 // provided nodeID. If the node is attempting to connect to the minimum number
 // of peers, then it should only connect if this node is a validator, or the
@@ -514,14 +565,26 @@ func (n *network) KnownPeers() ([]byte, []byte) {
 }
 
 func (n *network) Peers(except ids.NodeID, knownPeers *bloom.ReadFilter, salt []byte) []*ips.ClaimedIPPort {
+	numValidatorIPs, _ := n.peerListGossipParams()
 	return n.ipTracker.GetGossipableIPs(
 		except,
 		knownPeers,
 		salt,
-		int(n.config.PeerListNumValidatorIPs),
+		int(numValidatorIPs),
 	)
 }
 
+// peerListGossipParams returns the number of validator IPs to gossip and the
+// frequency to pull gossip peer lists, given the current number of connected
+// peers.
+func (n *network) peerListGossipParams() (uint32, time.Duration) {
+	n.peersLock.RLock()
+	numPeers := n.connectedPeers.Len()
+	n.peersLock.RUnlock()
+
+	return scalePeerListGossip(&n.config.PeerListGossipConfig, numPeers)
+}
+
 // Dispatch starts accepting connections from other nodes attempting to connect
 // to this node.
 func (n *network) Dispatch() error {
@@ -576,7 +639,7 @@ func (n *network) Dispatch() error {
 				zap.Stringer("peerIP", ip),
 			)
 
-			if err := n.upgrade(conn, n.serverUpgrader); err != nil {
+			if err := n.upgrade(conn, n.serverUpgrader, true); err != nil {
 				n.peerConfig.Log.Verbo("failed to upgrade connection",
 					zap.String("direction", "inbound"),
 					zap.Error(err),
@@ -797,6 +860,10 @@ func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 	defer n.peersLock.Unlock()
 
 	n.connectedPeers.Remove(nodeID)
+	ipStr := peer.IP().IPPort.String()
+	if claimedNodeID, ok := n.connectedIPs[ipStr]; ok && claimedNodeID == nodeID {
+		delete(n.connectedIPs, ipStr)
+	}
 
 	// The peer that is disconnecting from us finished the handshake
 	if ip, wantsConnection := n.ipTracker.GetIP(nodeID); wantsConnection {
@@ -927,7 +994,7 @@ func (n *network) dial(nodeID ids.NodeID, ip *trackedIP) {
 				zap.Stringer("peerIP", ip.ip),
 			)
 
-			err = n.upgrade(conn, n.clientUpgrader)
+			err = n.upgrade(conn, n.clientUpgrader, false)
 			if err != nil {
 				n.peerConfig.Log.Verbo(
 					"failed to upgrade, attempting again",
@@ -950,7 +1017,7 @@ func (n *network) dial(nodeID ids.NodeID, ip *trackedIP) {
 // If the connection is desired by the node, then the resulting upgraded
 // connection will be used to create a new peer. Otherwise the connection will
 // be immediately closed.
-func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader) error {
+func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader, isIngress bool) error {
 	upgradeTimeout := n.peerConfig.Clock.Time().Add(n.config.ReadHandshakeTimeout)
 	if err := conn.SetReadDeadline(upgradeTimeout); err != nil {
 		_ = conn.Close()
@@ -1053,9 +1120,42 @@ func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader) error {
 	)
 	n.connectingPeers.Add(peer)
 	n.peersLock.Unlock()
+
+	if n.config.PeerHandshakeTimeout > 0 {
+		go n.awaitHandshake(peer, tlsConn.RemoteAddr(), isIngress)
+	}
 	return nil
 }
 
+// awaitHandshake closes the connection to [p] if it doesn't finish the p2p
+// handshake within [PeerHandshakeTimeout]. If the connection was inbound, the
+// remote IP is backed off by the inbound connection upgrade throttler.
+func (n *network) awaitHandshake(p peer.Peer, remoteAddr net.Addr, isIngress bool) {
+	ctx, cancel := context.WithTimeout(n.onCloseCtx, n.config.PeerHandshakeTimeout)
+	defer cancel()
+
+	if err := p.AwaitReady(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	n.peerConfig.Log.Debug("disconnecting from peer",
+		zap.String("reason", "p2p handshake timed out"),
+		zap.Stringer("nodeID", p.ID()),
+		zap.Stringer("peerIP", remoteAddr),
+	)
+	n.metrics.handshakeTimeouts.Inc()
+	p.StartClose()
+
+	if !isIngress {
+		return
+	}
+	ip, err := ips.ToIPPort(remoteAddr.String())
+	if err != nil {
+		return
+	}
+	n.inboundConnUpgradeThrottler.RecordStall(ip)
+}
+
 func (n *network) PeerInfo(nodeIDs []ids.NodeID) []peer.Info {
 	n.peersLock.RLock()
 	defer n.peersLock.RUnlock()
@@ -1155,7 +1255,11 @@ func (n *network) NodeUptime(subnetID ids.ID) (UptimeResult, error) {
 }
 
 func (n *network) runTimers() {
-	pullGossipPeerlists := time.NewTicker(n.config.PeerListPullGossipFreq)
+	numValidatorIPs, pullGossipFreq := n.peerListGossipParams()
+	n.metrics.peerListNumValidatorIPs.Set(float64(numValidatorIPs))
+	n.metrics.peerListPullGossipFreq.Set(float64(pullGossipFreq))
+
+	pullGossipPeerlists := time.NewTicker(pullGossipFreq)
 	resetPeerListBloom := time.NewTicker(n.config.PeerListBloomResetFreq)
 	updateUptimes := time.NewTicker(n.config.UptimeMetricFreq)
 	defer func() {
@@ -1169,6 +1273,11 @@ func (n *network) runTimers() {
 			return
 		case <-pullGossipPeerlists.C:
 			n.pullGossipPeerLists()
+
+			numValidatorIPs, pullGossipFreq := n.peerListGossipParams()
+			n.metrics.peerListNumValidatorIPs.Set(float64(numValidatorIPs))
+			n.metrics.peerListPullGossipFreq.Set(float64(pullGossipFreq))
+			pullGossipPeerlists.Reset(pullGossipFreq)
 		case <-resetPeerListBloom.C:
 			if err := n.ipTracker.ResetBloom(); err != nil {
 				n.peerConfig.Log.Error("failed to reset ip tracker bloom filter",
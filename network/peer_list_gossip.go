@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// adaptiveGossipTargetPeers is the number of connected peers up to which
+	// adaptive peer list gossip uses the configured values unscaled.
+	adaptiveGossipTargetPeers = 64
+	// adaptiveGossipMaxBackoff is the largest factor by which adaptive peer
+	// list gossip reduces the configured gossip rate.
+	adaptiveGossipMaxBackoff = 8
+)
+
+// scalePeerListGossip returns the number of validator IPs to gossip and the
+// frequency to pull gossip peer lists when connected to [numPeers] peers.
+//
+// If adaptive gossip is enabled, both the number of IPs and the pull rate are
+// reduced proportionally to the number of peers beyond
+// adaptiveGossipTargetPeers, by at most adaptiveGossipMaxBackoff. The
+// configured values are never exceeded.
+func scalePeerListGossip(config *PeerListGossipConfig, numPeers int) (uint32, time.Duration) {
+	if !config.PeerListAdaptiveGossip || numPeers <= adaptiveGossipTargetPeers {
+		return config.PeerListNumValidatorIPs, config.PeerListPullGossipFreq
+	}
+
+	backoff := math.Min(
+		float64(numPeers)/adaptiveGossipTargetPeers,
+		adaptiveGossipMaxBackoff,
+	)
+	numValidatorIPs := uint32(math.Ceil(float64(config.PeerListNumValidatorIPs) / backoff))
+	pullGossipFreq := time.Duration(float64(config.PeerListPullGossipFreq) * backoff)
+	return numValidatorIPs, pullGossipFreq
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScalePeerListGossip(t *testing.T) {
+	tests := []struct {
+		name                    string
+		adaptive                bool
+		numPeers                int
+		expectedNumValidatorIPs uint32
+		expectedPullGossipFreq  time.Duration
+	}{
+		{
+			name:                    "static",
+			adaptive:                false,
+			numPeers:                10 * adaptiveGossipTargetPeers,
+			expectedNumValidatorIPs: 16,
+			expectedPullGossipFreq:  time.Second,
+		},
+		{
+			name:                    "adaptive with few peers",
+			adaptive:                true,
+			numPeers:                adaptiveGossipTargetPeers,
+			expectedNumValidatorIPs: 16,
+			expectedPullGossipFreq:  time.Second,
+		},
+		{
+			name:                    "adaptive with many peers",
+			adaptive:                true,
+			numPeers:                4 * adaptiveGossipTargetPeers,
+			expectedNumValidatorIPs: 4,
+			expectedPullGossipFreq:  4 * time.Second,
+		},
+		{
+			name:                    "adaptive backoff is bounded",
+			adaptive:                true,
+			numPeers:                100 * adaptiveGossipTargetPeers,
+			expectedNumValidatorIPs: 2,
+			expectedPullGossipFreq:  adaptiveGossipMaxBackoff * time.Second,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := &PeerListGossipConfig{
+				PeerListNumValidatorIPs: 16,
+				PeerListPullGossipFreq:  time.Second,
+				PeerListAdaptiveGossip:  test.adaptive,
+			}
+			numValidatorIPs, pullGossipFreq := scalePeerListGossip(config, test.numPeers)
+			require.Equal(test.expectedNumValidatorIPs, numValidatorIPs)
+			require.Equal(test.expectedPullGossipFreq, pullGossipFreq)
+		})
+	}
+}
@@ -84,6 +84,7 @@ func NewTestNetwork(
 		metrics,
 		"",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		constants.DefaultNetworkMaximumInboundTimeout,
 	)
 	if err != nil {
@@ -149,6 +150,7 @@ func NewTestNetwork(
 		TimeoutConfig: TimeoutConfig{
 			PingPongTimeout:      constants.DefaultPingPongTimeout,
 			ReadHandshakeTimeout: constants.DefaultNetworkReadHandshakeTimeout,
+			PeerHandshakeTimeout: constants.DefaultNetworkPeerHandshakeTimeout,
 		},
 
 		PeerListGossipConfig: PeerListGossipConfig{
@@ -164,6 +166,7 @@ func NewTestNetwork(
 
 		MaxClockDifference:           constants.DefaultNetworkMaxClockDifference,
 		CompressionType:              constants.DefaultNetworkCompressionType,
+		CompressionThreshold:         constants.DefaultNetworkCompressionThreshold,
 		PingFrequency:                constants.DefaultPingFrequency,
 		AllowPrivateIPs:              !constants.ProductionNetworkIDs.Contains(networkID),
 		UptimeMetricFreq:             constants.DefaultUptimeMetricFreq,
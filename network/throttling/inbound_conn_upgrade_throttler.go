@@ -7,12 +7,22 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 )
 
+const (
+	// maxStalledIPs is the maximum number of IPs whose stalled handshakes are
+	// remembered.
+	maxStalledIPs = 1024
+	// maxStallBackoff is the maximum amount of time inbound connections from an
+	// IP that stalled its handshakes will not be upgraded.
+	maxStallBackoff = 10 * time.Minute
+)
+
 var (
 	_ InboundConnUpgradeThrottler = (*inboundConnUpgradeThrottler)(nil)
 	_ InboundConnUpgradeThrottler = (*noInboundConnUpgradeThrottler)(nil)
@@ -37,6 +47,12 @@ type InboundConnUpgradeThrottler interface {
 	// If [ip] is a local IP, this method always returns true.
 	// Must not be called after [Stop] has been called.
 	ShouldUpgrade(ip ips.IPPort) bool
+	// RecordStall is called when a peer whose inbound connection from [ip] was
+	// upgraded didn't finish the p2p handshake in time. Inbound connections
+	// from [ip] will not be upgraded for a period that starts at the upgrade
+	// cooldown and doubles with every recorded stall.
+	// If [ip] is a local IP, this method does nothing.
+	RecordStall(ip ips.IPPort)
 }
 
 type InboundConnUpgradeThrottlerConfig struct {
@@ -63,6 +79,7 @@ func NewInboundConnUpgradeThrottler(log logging.Logger, config InboundConnUpgrad
 		log:                               log,
 		done:                              make(chan struct{}),
 		recentIPsAndTimes:                 make(chan ipAndTime, config.MaxRecentConnsUpgraded),
+		stalledIPs:                        &cache.LRU[string, stall]{Size: maxStalledIPs},
 	}
 }
 
@@ -77,11 +94,18 @@ func (*noInboundConnUpgradeThrottler) ShouldUpgrade(ips.IPPort) bool {
 	return true
 }
 
+func (*noInboundConnUpgradeThrottler) RecordStall(ips.IPPort) {}
+
 type ipAndTime struct {
 	ip                string
 	cooldownElapsedAt time.Time
 }
 
+type stall struct {
+	backoff        time.Duration
+	backoffEndTime time.Time
+}
+
 type inboundConnUpgradeThrottler struct {
 	InboundConnUpgradeThrottlerConfig
 	log  logging.Logger
@@ -98,6 +122,8 @@ type inboundConnUpgradeThrottler struct {
 	// For each IP in this channel, ShouldUpgrade(ipStr)
 	// returned true within the last [UpgradeCooldown].
 	recentIPsAndTimes chan ipAndTime
+	// IP --> Most recent handshake stall of a peer connecting from the IP
+	stalledIPs cache.Cacher[string, stall]
 }
 
 // Returns whether we should upgrade an inbound connection from [ipStr].
@@ -117,6 +143,11 @@ func (n *inboundConnUpgradeThrottler) ShouldUpgrade(ip ips.IPPort) bool {
 		return false
 	}
 
+	if stall, ok := n.stalledIPs.Get(ipStr); ok && n.clock.Time().Before(stall.backoffEndTime) {
+		// A peer connecting from this IP recently stalled its handshake
+		return false
+	}
+
 	select {
 	case n.recentIPsAndTimes <- ipAndTime{
 		ip:                ipStr,
@@ -129,6 +160,25 @@ func (n *inboundConnUpgradeThrottler) ShouldUpgrade(ip ips.IPPort) bool {
 	}
 }
 
+func (n *inboundConnUpgradeThrottler) RecordStall(ip ips.IPPort) {
+	if ip.IP.IsLoopback() {
+		// Don't rate-limit loopback IPs
+		return
+	}
+	ipStr := ip.IP.String()
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	backoff := n.UpgradeCooldown
+	if prevStall, ok := n.stalledIPs.Get(ipStr); ok {
+		backoff = min(2*prevStall.backoff, maxStallBackoff)
+	}
+	n.stalledIPs.Put(ipStr, stall{
+		backoff:        backoff,
+		backoffEndTime: n.clock.Time().Add(backoff),
+	})
+}
+
 func (n *inboundConnUpgradeThrottler) Dispatch() {
 	timer := time.NewTimer(0)
 	if !timer.Stop() {
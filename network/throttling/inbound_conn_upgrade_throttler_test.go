@@ -105,3 +105,38 @@ func TestInboundConnUpgradeThrottler(t *testing.T) {
 		require.FailNow("should be done")
 	}
 }
+
+func TestInboundConnUpgradeThrottlerRecordStall(t *testing.T) {
+	require := require.New(t)
+
+	cooldown := 5 * time.Second
+	throttlerIntf := NewInboundConnUpgradeThrottler(
+		logging.NoLog{},
+		InboundConnUpgradeThrottlerConfig{
+			UpgradeCooldown:        cooldown,
+			MaxRecentConnsUpgraded: 3,
+		},
+	)
+	throttler := throttlerIntf.(*inboundConnUpgradeThrottler)
+	now := time.Now()
+	throttler.clock.Set(now)
+
+	// The first stall backs off for [cooldown]
+	throttler.RecordStall(host1)
+	require.False(throttler.ShouldUpgrade(host1))
+
+	// Consecutive stalls double the backoff
+	throttler.RecordStall(host1)
+	throttler.clock.Set(now.Add(cooldown))
+	require.False(throttler.ShouldUpgrade(host1))
+
+	throttler.clock.Set(now.Add(2 * cooldown))
+	require.True(throttler.ShouldUpgrade(host1))
+
+	// Other IPs are unaffected
+	require.True(throttler.ShouldUpgrade(host2))
+
+	// Local host should never be rate-limited
+	throttler.RecordStall(loopbackIP)
+	require.True(throttler.ShouldUpgrade(loopbackIP))
+}
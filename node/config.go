@@ -104,6 +104,10 @@ type BootstrapConfig struct {
 	// ancestors while responding to a GetAncestors message
 	BootstrapMaxTimeGetAncestors time.Duration `json:"bootstrapMaxTimeGetAncestors"`
 
+	// Minimum number of beacons that must be connected before a chain starts
+	// bootstrapping
+	MinBootstrapPeers int `json:"minBootstrapPeers"`
+
 	Bootstrappers []genesis.Bootstrapper `json:"bootstrappers"`
 }
 
@@ -170,6 +174,27 @@ type Config struct {
 
 	TrackedSubnets set.Set[ids.ID] `json:"trackedSubnets"`
 
+	// ChainCreationConcurrency is the maximum number of chains that are
+	// created concurrently
+	ChainCreationConcurrency int `json:"chainCreationConcurrency"`
+
+	// ChainCreationOrder lists the subnets whose chains are created before
+	// the chains of other subnets, in the order they are created
+	ChainCreationOrder []ids.ID `json:"chainCreationOrder"`
+
+	// ChainIdleShutdownTimeout is how long a subnet chain may go without
+	// accepting a container before it is shut down. If 0, idle chains are
+	// never shut down.
+	ChainIdleShutdownTimeout time.Duration `json:"chainIdleShutdownTimeout"`
+
+	// MaxChainFxs is the maximum number of feature extensions a new chain may
+	// run
+	MaxChainFxs int `json:"maxChainFxs"`
+
+	// MaxTxInputs is the maximum number of inputs a P-chain tx may consume to
+	// be added to the mempool
+	MaxTxInputs int `json:"maxTxInputs"`
+
 	SubnetConfigs map[ids.ID]subnets.Config `json:"subnetConfigs"`
 
 	ChainConfigs map[string]chains.ChainConfig `json:"-"`
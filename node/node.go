@@ -190,6 +190,7 @@ func New(
 		n.MetricsRegisterer,
 		n.networkNamespace,
 		n.Config.NetworkConfig.CompressionType,
+		n.Config.NetworkConfig.CompressionThreshold,
 		n.Config.NetworkConfig.MaximumInboundMessageTimeout,
 	)
 	if err != nil {
@@ -1014,6 +1015,16 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 	}
 	cChainID := createEVMTx.ID()
 
+	standardNetworkID, cChainIDInUse, err := genesis.CChainIDInUse(n.Config.NetworkID, n.Config.GenesisBytes)
+	if err != nil {
+		return err
+	}
+	if cChainIDInUse {
+		n.Log.Warn("C-Chain chain ID is used by another network, EVM txs may be replayed across the networks",
+			zap.String("network", constants.NetworkName(standardNetworkID)),
+		)
+	}
+
 	// If any of these chains die, the node shuts down
 	criticalChains := set.Of(
 		constants.PlatformChainID,
@@ -1090,9 +1101,14 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			ChainConfigs:                            n.Config.ChainConfigs,
 			FrontierPollFrequency:                   n.Config.FrontierPollFrequency,
 			ConsensusAppConcurrency:                 n.Config.ConsensusAppConcurrency,
+			ChainCreationConcurrency:                n.Config.ChainCreationConcurrency,
+			ChainCreationOrder:                      n.Config.ChainCreationOrder,
+			ChainIdleShutdownTimeout:                n.Config.ChainIdleShutdownTimeout,
 			BootstrapMaxTimeGetAncestors:            n.Config.BootstrapMaxTimeGetAncestors,
 			BootstrapAncestorsMaxContainersSent:     n.Config.BootstrapAncestorsMaxContainersSent,
 			BootstrapAncestorsMaxContainersReceived: n.Config.BootstrapAncestorsMaxContainersReceived,
+			MinBootstrapPeers:                       n.Config.MinBootstrapPeers,
+			AdminAPIEnabled:                         n.Config.AdminAPIEnabled,
 			ApricotPhase4Time:                       version.GetApricotPhase4Time(n.Config.NetworkID),
 			ApricotPhase4MinPChainHeight:            version.ApricotPhase4MinPChainHeight[n.Config.NetworkID],
 			ResourceTracker:                         n.resourceTracker,
@@ -1126,32 +1142,7 @@ func (n *Node) initVMs() error {
 	eUpgradeTime := version.GetEUpgradeTime(n.Config.NetworkID)
 	err := utils.Err(
 		n.VMManager.RegisterFactory(context.TODO(), constants.PlatformVMID, &platformvm.Factory{
-			Config: platformconfig.Config{
-				Chains:                    n.chainManager,
-				Validators:                vdrs,
-				UptimeLockedCalculator:    n.uptimeCalculator,
-				SybilProtectionEnabled:    n.Config.SybilProtectionEnabled,
-				PartialSyncPrimaryNetwork: n.Config.PartialSyncPrimaryNetwork,
-				TrackedSubnets:            n.Config.TrackedSubnets,
-				StaticFeeConfig:           n.Config.StaticConfig,
-				UptimePercentage:          n.Config.UptimeRequirement,
-				MinValidatorStake:         n.Config.MinValidatorStake,
-				MaxValidatorStake:         n.Config.MaxValidatorStake,
-				MinDelegatorStake:         n.Config.MinDelegatorStake,
-				MinDelegationFee:          n.Config.MinDelegationFee,
-				MinStakeDuration:          n.Config.MinStakeDuration,
-				MaxStakeDuration:          n.Config.MaxStakeDuration,
-				RewardConfig:              n.Config.RewardConfig,
-				UpgradeConfig: upgrade.Config{
-					ApricotPhase3Time: version.GetApricotPhase3Time(n.Config.NetworkID),
-					ApricotPhase5Time: version.GetApricotPhase5Time(n.Config.NetworkID),
-					BanffTime:         version.GetBanffTime(n.Config.NetworkID),
-					CortinaTime:       version.GetCortinaTime(n.Config.NetworkID),
-					DurangoTime:       version.GetDurangoTime(n.Config.NetworkID),
-					EUpgradeTime:      eUpgradeTime,
-				},
-				UseCurrentHeight: n.Config.UseCurrentHeight,
-			},
+			Config: n.platformVMConfig(vdrs),
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.AVMID, &avm.Factory{
 			Config: avmconfig.Config{
@@ -1192,6 +1183,41 @@ func (n *Node) initVMs() error {
 	return err
 }
 
+// platformVMConfig returns the config of the P-chain, which validates the
+// primary network using [vdrs].
+func (n *Node) platformVMConfig(vdrs validators.Manager) platformconfig.Config {
+	return platformconfig.Config{
+		Chains:                            n.chainManager,
+		Validators:                        vdrs,
+		UptimeLockedCalculator:            n.uptimeCalculator,
+		SybilProtectionEnabled:            n.Config.SybilProtectionEnabled,
+		PartialSyncPrimaryNetwork:         n.Config.PartialSyncPrimaryNetwork,
+		TrackedSubnets:                    n.Config.TrackedSubnets,
+		StaticFeeConfig:                   n.Config.StaticConfig,
+		UptimePercentage:                  n.Config.UptimeRequirement,
+		MinValidatorStake:                 n.Config.MinValidatorStake,
+		MaxValidatorStake:                 n.Config.MaxValidatorStake,
+		MinDelegatorStake:                 n.Config.MinDelegatorStake,
+		MinDelegationFee:                  n.Config.MinDelegationFee,
+		MinStakeDuration:                  n.Config.MinStakeDuration,
+		MaxStakeDuration:                  n.Config.MaxStakeDuration,
+		SubnetValidatorRemovalGracePeriod: n.Config.SubnetValidatorRemovalGracePeriod,
+		MaxChainFxs:                       n.Config.MaxChainFxs,
+		MaxTxInputs:                       n.Config.MaxTxInputs,
+		RewardConfig:                      n.Config.RewardConfig,
+		UpgradeConfig: upgrade.Config{
+			ApricotPhase3Time: version.GetApricotPhase3Time(n.Config.NetworkID),
+			ApricotPhase5Time: version.GetApricotPhase5Time(n.Config.NetworkID),
+			BanffTime:         version.GetBanffTime(n.Config.NetworkID),
+			CortinaTime:       version.GetCortinaTime(n.Config.NetworkID),
+			DurangoTime:       version.GetDurangoTime(n.Config.NetworkID),
+			EUpgradeTime:      version.GetEUpgradeTime(n.Config.NetworkID),
+		},
+		UseCurrentHeight: n.Config.UseCurrentHeight,
+		AdminAPIEnabled:  n.Config.AdminAPIEnabled,
+	}
+}
+
 // initSharedMemory initializes the shared memory for cross chain interation
 func (n *Node) initSharedMemory() {
 	n.Log.Info("initializing SharedMemory")
@@ -1262,15 +1288,17 @@ func (n *Node) initAdminAPI() error {
 	n.Log.Info("initializing admin API")
 	service, err := admin.NewService(
 		admin.Config{
-			Log:          n.Log,
-			DB:           n.DB,
-			ChainManager: n.chainManager,
-			HTTPServer:   n.APIServer,
-			ProfileDir:   n.Config.ProfilerConfig.Dir,
-			LogFactory:   n.LogFactory,
-			NodeConfig:   n.Config,
-			VMManager:    n.VMManager,
-			VMRegistry:   n.VMRegistry,
+			Log:           n.Log,
+			DB:            n.DB,
+			ChainManager:  n.chainManager,
+			HTTPServer:    n.APIServer,
+			ProfileDir:    n.Config.ProfilerConfig.Dir,
+			LogFactory:    n.LogFactory,
+			NodeConfig:    n.Config,
+			VMManager:     n.VMManager,
+			VMRegistry:    n.VMRegistry,
+			StakingSigner: n.StakingTLSSigner,
+			StakingCert:   n.StakingTLSCert,
 		},
 	)
 	if err != nil {
@@ -1580,6 +1608,7 @@ func (n *Node) shutdown() {
 		n.profiler.Shutdown()
 	}
 	if n.Net != nil {
+		n.Log.Info("shutting down networking")
 		n.Net.StartClose()
 	}
 	if err := n.APIServer.Shutdown(); err != nil {
@@ -1599,7 +1628,11 @@ func (n *Node) shutdown() {
 	n.Log.Info("cleaning up plugin runtimes")
 	n.runtimeManager.Stop(context.TODO())
 
+	// The database must be closed last, after every chain has been shutdown
+	// and all plugin runtimes have exited, so that no in-flight writes are
+	// lost.
 	if n.DB != nil {
+		n.Log.Info("closing database")
 		if err := n.DB.Delete(ungracefulShutdown); err != nil {
 			n.Log.Error(
 				"failed to delete ungraceful shutdown key",
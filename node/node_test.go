@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+func TestPlatformVMConfigRemovalGracePeriod(t *testing.T) {
+	require := require.New(t)
+
+	stakingConfig := genesis.GetStakingConfig(constants.LocalID)
+	stakingConfig.SubnetValidatorRemovalGracePeriod = time.Hour
+	n := &Node{
+		Config: &Config{
+			NetworkID: constants.LocalID,
+			StakingConfig: StakingConfig{
+				StakingConfig: stakingConfig,
+			},
+		},
+	}
+
+	config := n.platformVMConfig(validators.NewManager())
+	require.Equal(time.Hour, config.SubnetValidatorRemovalGracePeriod)
+	require.Equal(time.Hour, config.RemovalGracePeriod(config.UpgradeConfig.EUpgradeTime))
+	require.Zero(config.RemovalGracePeriod(config.UpgradeConfig.EUpgradeTime.Add(-time.Second)))
+}
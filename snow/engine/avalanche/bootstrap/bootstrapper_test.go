@@ -85,7 +85,7 @@ func newConfig(t *testing.T) (Config, ids.NodeID, *common.SenderTest, *vertex.Te
 	peerTracker := tracker.NewPeers()
 	totalWeight, err := vdrs.TotalWeight(constants.PrimaryNetworkID)
 	require.NoError(err)
-	startupTracker := tracker.NewStartup(peerTracker, totalWeight/2+1)
+	startupTracker := tracker.NewStartup(peerTracker, totalWeight/2+1, 0)
 	vdrs.RegisterSetCallbackListener(constants.PrimaryNetworkID, startupTracker)
 
 	avaGetHandler, err := getter.New(manager, sender, ctx.Log, time.Second, 2000, ctx.AvalancheRegisterer)
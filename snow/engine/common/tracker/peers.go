@@ -31,6 +31,9 @@ type Peers interface {
 	ConnectedWeight() uint64
 	// ConnectedPercent returns the currently connected stake percentage [0, 1]
 	ConnectedPercent() float64
+	// NumConnectedValidators returns the number of currently connected
+	// validators
+	NumConnectedValidators() int
 	// SampleValidator returns a randomly selected connected validator. If there
 	// are no currently connected validators then it will return false.
 	SampleValidator() (ids.NodeID, bool)
@@ -98,6 +101,13 @@ func (p *lockedPeers) ConnectedPercent() float64 {
 	return p.peers.ConnectedPercent()
 }
 
+func (p *lockedPeers) NumConnectedValidators() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.peers.NumConnectedValidators()
+}
+
 func (p *lockedPeers) SampleValidator() (ids.NodeID, bool) {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -109,6 +119,7 @@ type meteredPeers struct {
 	Peers
 
 	percentConnected prometheus.Gauge
+	numConnected     prometheus.Gauge
 	numValidators    prometheus.Gauge
 	totalWeight      prometheus.Gauge
 }
@@ -119,6 +130,11 @@ func NewMeteredPeers(namespace string, reg prometheus.Registerer) (Peers, error)
 		Name:      "percent_connected",
 		Help:      "Percent of connected stake",
 	})
+	numConnected := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "num_connected_validators",
+		Help:      "Number of connected validators",
+	})
 	totalWeight := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Name:      "total_weight",
@@ -131,6 +147,7 @@ func NewMeteredPeers(namespace string, reg prometheus.Registerer) (Peers, error)
 	})
 	err := utils.Err(
 		reg.Register(percentConnected),
+		reg.Register(numConnected),
 		reg.Register(totalWeight),
 		reg.Register(numValidators),
 	)
@@ -140,6 +157,7 @@ func NewMeteredPeers(namespace string, reg prometheus.Registerer) (Peers, error)
 				validators: make(map[ids.NodeID]uint64),
 			},
 			percentConnected: percentConnected,
+			numConnected:     numConnected,
 			totalWeight:      totalWeight,
 			numValidators:    numValidators,
 		},
@@ -151,6 +169,7 @@ func (p *meteredPeers) OnValidatorAdded(nodeID ids.NodeID, pk *bls.PublicKey, tx
 	p.numValidators.Inc()
 	p.totalWeight.Add(float64(weight))
 	p.percentConnected.Set(p.Peers.ConnectedPercent())
+	p.numConnected.Set(float64(p.Peers.NumConnectedValidators()))
 }
 
 func (p *meteredPeers) OnValidatorRemoved(nodeID ids.NodeID, weight uint64) {
@@ -158,6 +177,7 @@ func (p *meteredPeers) OnValidatorRemoved(nodeID ids.NodeID, weight uint64) {
 	p.numValidators.Dec()
 	p.totalWeight.Sub(float64(weight))
 	p.percentConnected.Set(p.Peers.ConnectedPercent())
+	p.numConnected.Set(float64(p.Peers.NumConnectedValidators()))
 }
 
 func (p *meteredPeers) OnValidatorWeightChanged(nodeID ids.NodeID, oldWeight, newWeight uint64) {
@@ -170,12 +190,14 @@ func (p *meteredPeers) OnValidatorWeightChanged(nodeID ids.NodeID, oldWeight, ne
 func (p *meteredPeers) Connected(ctx context.Context, nodeID ids.NodeID, version *version.Application) error {
 	err := p.Peers.Connected(ctx, nodeID, version)
 	p.percentConnected.Set(p.Peers.ConnectedPercent())
+	p.numConnected.Set(float64(p.Peers.NumConnectedValidators()))
 	return err
 }
 
 func (p *meteredPeers) Disconnected(ctx context.Context, nodeID ids.NodeID) error {
 	err := p.Peers.Disconnected(ctx, nodeID)
 	p.percentConnected.Set(p.Peers.ConnectedPercent())
+	p.numConnected.Set(float64(p.Peers.NumConnectedValidators()))
 	return err
 }
 
@@ -250,6 +272,10 @@ func (p *peerData) ConnectedPercent() float64 {
 	return float64(p.connectedWeight) / float64(p.totalWeight)
 }
 
+func (p *peerData) NumConnectedValidators() int {
+	return p.connectedValidators.Len()
+}
+
 func (p *peerData) SampleValidator() (ids.NodeID, bool) {
 	return p.connectedValidators.Peek()
 }
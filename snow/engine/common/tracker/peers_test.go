@@ -27,12 +27,14 @@ func TestPeers(t *testing.T) {
 
 	require.NoError(p.Connected(context.Background(), nodeID, version.CurrentApp))
 	require.Equal(uint64(5), p.ConnectedWeight())
+	require.Equal(1, p.NumConnectedValidators())
 
 	p.OnValidatorWeightChanged(nodeID, 5, 10)
 	require.Equal(uint64(10), p.ConnectedWeight())
 
 	p.OnValidatorRemoved(nodeID, 10)
 	require.Zero(p.ConnectedWeight())
+	require.Zero(p.NumConnectedValidators())
 
 	p.OnValidatorAdded(nodeID, nil, ids.Empty, 5)
 	require.Equal(uint64(5), p.ConnectedWeight())
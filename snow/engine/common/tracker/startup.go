@@ -25,15 +25,21 @@ type startup struct {
 
 	lock          sync.RWMutex
 	startupWeight uint64
+	startupPeers  int
 	shouldStart   bool
 }
 
-func NewStartup(peers Peers, startupWeight uint64) Startup {
-	return &startup{
+// NewStartup returns a Startup that reports the protocol should start once at
+// least [startupWeight] of stake and at least [startupPeers] validators are
+// connected.
+func NewStartup(peers Peers, startupWeight uint64, startupPeers int) Startup {
+	s := &startup{
 		Peers:         peers,
 		startupWeight: startupWeight,
-		shouldStart:   peers.ConnectedWeight() >= startupWeight,
+		startupPeers:  startupPeers,
 	}
+	s.shouldStart = s.sufficientlyConnected()
+	return s
 }
 
 func (s *startup) OnValidatorAdded(nodeID ids.NodeID, pk *bls.PublicKey, txID ids.ID, weight uint64) {
@@ -41,7 +47,7 @@ func (s *startup) OnValidatorAdded(nodeID ids.NodeID, pk *bls.PublicKey, txID id
 	defer s.lock.Unlock()
 
 	s.Peers.OnValidatorAdded(nodeID, pk, txID, weight)
-	s.shouldStart = s.shouldStart || s.Peers.ConnectedWeight() >= s.startupWeight
+	s.shouldStart = s.shouldStart || s.sufficientlyConnected()
 }
 
 func (s *startup) OnValidatorWeightChanged(nodeID ids.NodeID, oldWeight, newWeight uint64) {
@@ -49,7 +55,7 @@ func (s *startup) OnValidatorWeightChanged(nodeID ids.NodeID, oldWeight, newWeig
 	defer s.lock.Unlock()
 
 	s.Peers.OnValidatorWeightChanged(nodeID, oldWeight, newWeight)
-	s.shouldStart = s.shouldStart || s.Peers.ConnectedWeight() >= s.startupWeight
+	s.shouldStart = s.shouldStart || s.sufficientlyConnected()
 }
 
 func (s *startup) Connected(ctx context.Context, nodeID ids.NodeID, nodeVersion *version.Application) error {
@@ -60,7 +66,7 @@ func (s *startup) Connected(ctx context.Context, nodeID ids.NodeID, nodeVersion
 		return err
 	}
 
-	s.shouldStart = s.shouldStart || s.Peers.ConnectedWeight() >= s.startupWeight
+	s.shouldStart = s.shouldStart || s.sufficientlyConnected()
 	return nil
 }
 
@@ -70,3 +76,8 @@ func (s *startup) ShouldStart() bool {
 
 	return s.shouldStart
 }
+
+func (s *startup) sufficientlyConnected() bool {
+	return s.Peers.ConnectedWeight() >= s.startupWeight &&
+		s.Peers.NumConnectedValidators() >= s.startupPeers
+}
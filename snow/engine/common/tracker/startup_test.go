@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tracker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+func TestStartupRequiresMinPeers(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	nodeID1 := ids.GenerateTestNodeID()
+
+	peers := NewPeers()
+	peers.OnValidatorAdded(nodeID0, nil, ids.Empty, 10)
+	peers.OnValidatorAdded(nodeID1, nil, ids.Empty, 1)
+
+	s := NewStartup(peers, 10, 2)
+	require.False(s.ShouldStart())
+
+	// Enough stake is connected, but not enough peers.
+	require.NoError(s.Connected(context.Background(), nodeID0, version.CurrentApp))
+	require.False(s.ShouldStart())
+
+	require.NoError(s.Connected(context.Background(), nodeID1, version.CurrentApp))
+	require.True(s.ShouldStart())
+
+	// Once started, disconnecting doesn't stop the protocol.
+	require.NoError(s.Disconnected(context.Background(), nodeID1))
+	require.True(s.ShouldStart())
+}
@@ -5,12 +5,17 @@ package block
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 )
 
+// ErrTransientBuildFailure may be wrapped by the error returned from
+// BuildBlock to signal that building the block may succeed if retried.
+var ErrTransientBuildFailure = errors.New("transient block build failure")
+
 // ChainVM defines the required functionality of a Snowman VM.
 //
 // A Snowman VM is responsible for defining the representation of state,
@@ -34,7 +39,8 @@ type ChainVM interface {
 	// Attempt to create a new block from data contained in the VM.
 	//
 	// If the VM doesn't want to issue a new block, an error should be
-	// returned.
+	// returned. If the VM failed to build a block but may succeed if asked
+	// again, the error should wrap ErrTransientBuildFailure.
 	BuildBlock(context.Context) (snowman.Block, error)
 
 	// Notify the VM of the currently preferred block.
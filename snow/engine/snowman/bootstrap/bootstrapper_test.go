@@ -71,7 +71,7 @@ func newConfig(t *testing.T) (Config, ids.NodeID, *common.SenderTest, *block.Tes
 
 	totalWeight, err := vdrs.TotalWeight(ctx.SubnetID)
 	require.NoError(err)
-	startupTracker := tracker.NewStartup(tracker.NewPeers(), totalWeight/2+1)
+	startupTracker := tracker.NewStartup(tracker.NewPeers(), totalWeight/2+1, 0)
 	vdrs.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 
 	require.NoError(startupTracker.Connected(context.Background(), peer, version.CurrentApp))
@@ -124,7 +124,7 @@ func TestBootstrapperStartsOnlyIfEnoughStakeIsConnected(t *testing.T) {
 	alpha := uint64(10)
 	startupAlpha := alpha
 
-	startupTracker := tracker.NewStartup(tracker.NewPeers(), startupAlpha)
+	startupTracker := tracker.NewStartup(tracker.NewPeers(), startupAlpha, 0)
 	peers.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 
 	snowGetHandler, err := getter.New(vm, sender, ctx.Log, time.Second, 2000, ctx.Registerer)
@@ -638,7 +638,7 @@ func TestBootstrapNoParseOnNew(t *testing.T) {
 
 	totalWeight, err := peers.TotalWeight(ctx.SubnetID)
 	require.NoError(err)
-	startupTracker := tracker.NewStartup(tracker.NewPeers(), totalWeight/2+1)
+	startupTracker := tracker.NewStartup(tracker.NewPeers(), totalWeight/2+1, 0)
 	peers.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 	require.NoError(startupTracker.Connected(context.Background(), peer, version.CurrentApp))
 
@@ -115,7 +115,7 @@ func TestStateSyncingStartsOnlyIfEnoughStakeIsConnected(t *testing.T) {
 	startupAlpha := alpha
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, _, sender := buildTestsObjects(t, ctx, startup, beacons, alpha)
@@ -158,7 +158,7 @@ func TestStateSyncLocalSummaryIsIncludedAmongFrontiersIfAvailable(t *testing.T)
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, _ := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -196,7 +196,7 @@ func TestStateSyncNotFoundOngoingSummaryIsNotIncludedAmongFrontiers(t *testing.T
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, _ := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -227,7 +227,7 @@ func TestBeaconsAreReachedForFrontiersUponStartup(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, _, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -266,7 +266,7 @@ func TestUnRequestedStateSummaryFrontiersAreDropped(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -356,7 +356,7 @@ func TestMalformedStateSummaryFrontiersAreDropped(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -425,7 +425,7 @@ func TestLateResponsesFromUnresponsiveFrontiersAreNotRecorded(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -508,7 +508,7 @@ func TestStateSyncIsRestartedIfTooManyFrontierSeedersTimeout(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -597,7 +597,7 @@ func TestVoteRequestsAreSentAsAllFrontierBeaconsResponded(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -668,7 +668,7 @@ func TestUnRequestedVotesAreDropped(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -785,7 +785,7 @@ func TestVotesForUnknownSummariesAreDropped(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
@@ -889,7 +889,7 @@ func TestStateSummaryIsPassedToVMAsMajorityOfVotesIsCastedForIt(t *testing.T) {
 	alpha := (totalWeight + 1) / 2
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, alpha)
@@ -1034,7 +1034,7 @@ func TestVotingIsRestartedIfMajorityIsNotReachedDueToTimeouts(t *testing.T) {
 	alpha := (totalWeight + 1) / 2
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, alpha)
@@ -1140,7 +1140,7 @@ func TestStateSyncIsStoppedIfEnoughVotesAreCastedWithNoClearMajority(t *testing.
 	alpha := (totalWeight + 1) / 2
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, alpha)
@@ -1285,7 +1285,7 @@ func TestStateSyncIsDoneOnceVMNotifies(t *testing.T) {
 	startupAlpha := (3*totalWeight + 3) / 4
 
 	peers := tracker.NewPeers()
-	startup := tracker.NewStartup(peers, startupAlpha)
+	startup := tracker.NewStartup(peers, startupAlpha, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	syncer, _, _ := buildTestsObjects(t, ctx, startup, beacons, (totalWeight+1)/2)
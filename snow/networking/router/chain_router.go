@@ -128,7 +128,11 @@ func (cr *ChainRouter) Initialize(
 	// Register metrics
 	rMetrics, err := newRouterMetrics(metricsNamespace, metricsRegisterer)
 	if err != nil {
-		return err
+		// Failing to export the router's metrics shouldn't prevent the node
+		// from routing messages.
+		cr.log.Warn("failed to register router metrics",
+			zap.Error(err),
+		)
 	}
 	cr.metrics = rMetrics
 	return nil
@@ -393,7 +397,7 @@ func (cr *ChainRouter) AddChain(ctx context.Context, chain handler.Handler) {
 		zap.Stringer("chainID", chainID),
 	)
 	chain.SetOnStopped(func() {
-		cr.removeChain(ctx, chainID)
+		cr.removeChain(ctx, chain)
 	})
 	cr.chainHandlers[chainID] = chain
 
@@ -632,10 +636,13 @@ func (cr *ChainRouter) HealthCheck(context.Context) (interface{}, error) {
 
 // RemoveChain removes the specified chain so that incoming
 // messages can't be routed to it
-func (cr *ChainRouter) removeChain(ctx context.Context, chainID ids.ID) {
+func (cr *ChainRouter) removeChain(ctx context.Context, chain handler.Handler) {
+	chainID := chain.Context().ChainID
+
 	cr.lock.Lock()
-	chain, exists := cr.chainHandlers[chainID]
-	if !exists {
+	// If the chain was re-created, the handler routed to may be a newer one
+	// that must not be removed.
+	if registered, exists := cr.chainHandlers[chainID]; !exists || registered != chain {
 		cr.log.Debug("can't remove unknown chain",
 			zap.Stringer("chainID", chainID),
 		)
@@ -7,6 +7,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/metric"
 )
 
 // routerMetrics about router messages
@@ -40,10 +41,14 @@ func newRouterMetrics(namespace string, registerer prometheus.Registerer) (*rout
 		},
 	)
 
-	err := utils.Err(
-		registerer.Register(rMetrics.outstandingRequests),
-		registerer.Register(rMetrics.longestRunningRequest),
-		registerer.Register(rMetrics.droppedRequests),
-	)
-	return rMetrics, err
+	// An already registered dropped requests counter is shared, so that the
+	// drops of every router are counted. The gauges track the state of a
+	// single router, so they are never shared. If registration fails, the
+	// unregistered metrics are still returned so that the router can continue
+	// without exporting them.
+	outstandingErr := registerer.Register(rMetrics.outstandingRequests)
+	longestRunningErr := registerer.Register(rMetrics.longestRunningRequest)
+	var droppedErr error
+	rMetrics.droppedRequests, droppedErr = metric.RegisterOrExistingCounter(registerer, rMetrics.droppedRequests)
+	return rMetrics, utils.Err(outstandingErr, longestRunningErr, droppedErr)
 }
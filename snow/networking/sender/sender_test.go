@@ -72,6 +72,7 @@ func TestTimeout(t *testing.T) {
 		metrics,
 		"dummyNamespace",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -349,6 +350,7 @@ func TestReliableMessages(t *testing.T) {
 		metrics,
 		"dummyNamespace",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -506,6 +508,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		metrics,
 		"dummyNamespace",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -35,9 +35,8 @@ func (m *metrics) RegisterChain(ctx *snow.ConsensusContext) error {
 	if m.chainToMetrics == nil {
 		m.chainToMetrics = map[ids.ID]*chainMetrics{}
 	}
-	if _, exists := m.chainToMetrics[ctx.ChainID]; exists {
-		return fmt.Errorf("chain %s has already been registered", ctx.ChainID)
-	}
+	// A chain that is re-created after being shut down replaces the metrics of
+	// its previous instance.
 	cm, err := newChainMetrics(ctx.Registerer)
 	if err != nil {
 		return fmt.Errorf("couldn't create metrics for chain %s: %w", ctx.ChainID, err)
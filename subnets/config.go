@@ -13,7 +13,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
-var errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+var (
+	errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+	errNonPositiveActivationWarning     = errors.New("proposerActivationWarnings must be positive")
+	errNegativeBuildBlockRetries        = errors.New("proposerBuildBlockRetries must be >= 0")
+	errNegativeBuildBlockRetryBackoff   = errors.New("proposerBuildBlockRetryBackoff must be >= 0")
+)
 
 type Config struct {
 	// ValidatorOnly indicates that this Subnet's Chains are available to only subnet validators.
@@ -31,6 +36,23 @@ type Config struct {
 	//
 	// TODO: Remove this flag once all VMs throttle their own block production.
 	ProposerMinBlockDelay time.Duration `json:"proposerMinBlockDelay" yaml:"proposerMinBlockDelay"`
+	// ProposerFreeForAllDelay is the delay after the parent block's timestamp
+	// at which this node builds an unsigned snowman++ block prior to Durango.
+	// It is clamped between 30 seconds and 5 minutes.
+	ProposerFreeForAllDelay time.Duration `json:"proposerFreeForAllDelay" yaml:"proposerFreeForAllDelay"`
+	// ProposerBuildJitter is the upper bound of the delay this node adds to
+	// its scheduled snowman++ block building time prior to Durango. The delay
+	// is derived from the node's ID to avoid validators with the same proposal
+	// window from building blocks simultaneously. If set to 0, no delay is
+	// added.
+	ProposerBuildJitter time.Duration `json:"proposerBuildJitter" yaml:"proposerBuildJitter"`
+	// ProposerBuildBlockRetries is the number of additional attempts this node
+	// makes to build an inner block after the VM reported a transient failure.
+	// If set to 0, failures are never retried.
+	ProposerBuildBlockRetries int `json:"proposerBuildBlockRetries" yaml:"proposerBuildBlockRetries"`
+	// ProposerBuildBlockRetryBackoff is the initial delay between attempts to
+	// build an inner block. The delay doubles after every failed attempt.
+	ProposerBuildBlockRetryBackoff time.Duration `json:"proposerBuildBlockRetryBackoff" yaml:"proposerBuildBlockRetryBackoff"`
 	// ProposerNumHistoricalBlocks is the number of historical snowman++ blocks
 	// this node will index per chain. If set to 0, the node will index all
 	// snowman++ blocks.
@@ -49,6 +71,10 @@ type Config struct {
 	// TODO: Move this flag once the proposervm is configurable on a per-chain
 	// basis.
 	ProposerNumHistoricalBlocks uint64 `json:"proposerNumHistoricalBlocks" yaml:"proposerNumHistoricalBlocks"`
+	// ProposerActivationWarnings are the durations before the snowman++
+	// activation time at which this node will log a warning that the fork is
+	// approaching.
+	ProposerActivationWarnings []time.Duration `json:"proposerActivationWarnings" yaml:"proposerActivationWarnings"`
 }
 
 func (c *Config) Valid() error {
@@ -58,5 +84,16 @@ func (c *Config) Valid() error {
 	if !c.ValidatorOnly && c.AllowedNodes.Len() > 0 {
 		return errAllowedNodesWhenNotValidatorOnly
 	}
+	if c.ProposerBuildBlockRetries < 0 {
+		return errNegativeBuildBlockRetries
+	}
+	if c.ProposerBuildBlockRetryBackoff < 0 {
+		return errNegativeBuildBlockRetryBackoff
+	}
+	for _, warning := range c.ProposerActivationWarnings {
+		if warning <= 0 {
+			return fmt.Errorf("%w: %s", errNonPositiveActivationWarning, warning)
+		}
+	}
 	return nil
 }
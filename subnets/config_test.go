@@ -5,6 +5,7 @@ package subnets
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -49,6 +50,30 @@ func TestValid(t *testing.T) {
 			},
 			expectedErr: errAllowedNodesWhenNotValidatorOnly,
 		},
+		{
+			name: "invalid proposer activation warning",
+			s: Config{
+				ConsensusParameters:        validParameters,
+				ProposerActivationWarnings: []time.Duration{time.Minute, 0},
+			},
+			expectedErr: errNonPositiveActivationWarning,
+		},
+		{
+			name: "negative proposer build block retries",
+			s: Config{
+				ConsensusParameters:       validParameters,
+				ProposerBuildBlockRetries: -1,
+			},
+			expectedErr: errNegativeBuildBlockRetries,
+		},
+		{
+			name: "negative proposer build block retry backoff",
+			s: Config{
+				ConsensusParameters:            validParameters,
+				ProposerBuildBlockRetryBackoff: -time.Millisecond,
+			},
+			expectedErr: errNegativeBuildBlockRetryBackoff,
+		},
 		{
 			name: "valid",
 			s: Config{
@@ -45,8 +45,10 @@ const (
 	DefaultNetworkTimeoutHalflife       = 5 * time.Minute
 	DefaultNetworkTimeoutCoefficient    = 2
 	DefaultNetworkReadHandshakeTimeout  = 15 * time.Second
+	DefaultNetworkPeerHandshakeTimeout  = 5 * time.Second
 
 	DefaultNetworkCompressionType           = compression.TypeZstd
+	DefaultNetworkCompressionThreshold      = 256
 	DefaultNetworkMaxClockDifference        = time.Minute
 	DefaultNetworkRequireValidatorToConnect = false
 	DefaultNetworkPeerReadBufferSize        = 8 * units.KiB
@@ -6,6 +6,7 @@ package logging
 import (
 	"io"
 	"os"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -114,6 +115,13 @@ func (l *log) Verbo(msg string, fields ...zap.Field) {
 	l.log(Verbo, msg, fields...)
 }
 
+func (l *log) With(fields ...zap.Field) Logger {
+	return &log{
+		wrappedCores:   l.wrappedCores,
+		internalLogger: l.internalLogger.With(fields...),
+	}
+}
+
 func (l *log) SetLevel(level Level) {
 	for _, core := range l.wrappedCores {
 		core.AtomicLevel.SetLevel(zapcore.Level(level))
@@ -145,3 +153,35 @@ func (l *log) RecoverAndExit(f, exit func()) {
 	defer l.stopAndExit(exit)
 	f()
 }
+
+func (l *log) RecoverAndRestart(f func(), maxRestarts int, backoff time.Duration) {
+	for restarts := 0; l.recoverAndReport(f, restarts < maxRestarts); restarts++ {
+		l.Warn("restarting after panic",
+			zap.Int("restarts", restarts+1),
+			zap.Int("maxRestarts", maxRestarts),
+			zap.Duration("backoff", backoff),
+		)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// recoverAndReport runs [f] and returns true if it panicked. If [restartable]
+// is false, the panic is rethrown instead.
+func (l *log) recoverAndReport(f func(), restartable bool) (panicked bool) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if !restartable {
+			l.Fatal("panicking", zap.Any("reason", r), zap.Stack("from"))
+			l.Stop()
+			panic(r)
+		}
+		l.Error("recovered from panic", zap.Any("reason", r), zap.Stack("from"))
+		panicked = true
+	}()
+	f()
+	return false
+}
@@ -4,9 +4,12 @@
 package logging
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestLog(t *testing.T) {
@@ -23,3 +26,66 @@ func TestLog(t *testing.T) {
 
 	require.True(t, *recovered)
 }
+
+type bufferCloser struct {
+	bytes.Buffer
+}
+
+func (*bufferCloser) Close() error {
+	return nil
+}
+
+func TestLogWith(t *testing.T) {
+	require := require.New(t)
+
+	for _, format := range []Format{Plain, JSON} {
+		buf := &bufferCloser{}
+		log := NewLogger("", NewWrappedCore(Info, buf, format.ConsoleEncoder()))
+		chainLog := log.With(zap.String("chainID", "testChain"))
+
+		chainLog.Info("chain message", zap.Int("n", 1))
+		require.Contains(buf.String(), "testChain")
+		require.Contains(buf.String(), "chain message")
+
+		buf.Reset()
+		log.Info("node message")
+		require.Contains(buf.String(), "node message")
+		require.NotContains(buf.String(), "testChain")
+	}
+}
+
+func TestRecoverAndRestart(t *testing.T) {
+	require := require.New(t)
+
+	log := NewLogger("", NewWrappedCore(Info, Discard, Plain.ConsoleEncoder()))
+
+	// A function that stops panicking within the restart budget returns
+	// normally.
+	numCalls := 0
+	log.RecoverAndRestart(
+		func() {
+			numCalls++
+			if numCalls <= 2 {
+				panic("DON'T PANIC!")
+			}
+		},
+		2,
+		time.Millisecond,
+	)
+	require.Equal(3, numCalls)
+
+	// A function that keeps panicking has its panic rethrown once the restart
+	// budget is exhausted.
+	numCalls = 0
+	require.PanicsWithValue("DON'T PANIC!", func() {
+		log.RecoverAndRestart(
+			func() {
+				numCalls++
+				panic("DON'T PANIC!")
+			},
+			2,
+			time.Millisecond,
+		)
+	})
+	require.Equal(3, numCalls)
+}
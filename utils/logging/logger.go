@@ -5,6 +5,7 @@ package logging
 
 import (
 	"io"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -40,6 +41,10 @@ type Logger interface {
 	// aspect of the program
 	Verbo(msg string, fields ...zap.Field)
 
+	// With returns a logger that includes [fields] in every log line, in
+	// addition to the fields passed at each call site.
+	With(fields ...zap.Field) Logger
+
 	// SetLevel that this logger should log to
 	SetLevel(level Level)
 	// Enabled returns true if the given level is at or above this level.
@@ -56,6 +61,12 @@ type Logger interface {
 	// executes the desired exit function
 	RecoverAndExit(f, exit func())
 
+	// If a function panics, this will log that panic and then run the function
+	// again after [backoff]. The backoff doubles after every restart. Once the
+	// function has been restarted [maxRestarts] times, the next panic is logged
+	// and rethrown.
+	RecoverAndRestart(f func(), maxRestarts int, backoff time.Duration)
+
 	// Stop this logger and write back all meta-data.
 	Stop()
 }
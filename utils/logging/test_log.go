@@ -5,6 +5,7 @@ package logging
 
 import (
 	"io"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -36,6 +37,10 @@ func (NoLog) Debug(string, ...zap.Field) {}
 
 func (NoLog) Verbo(string, ...zap.Field) {}
 
+func (n NoLog) With(...zap.Field) Logger {
+	return n
+}
+
 func (NoLog) SetLevel(Level) {}
 
 func (NoLog) Enabled(Level) bool {
@@ -53,10 +58,18 @@ func (NoLog) RecoverAndExit(f, exit func()) {
 	f()
 }
 
+func (NoLog) RecoverAndRestart(f func(), _ int, _ time.Duration) {
+	f()
+}
+
 func (NoLog) Stop() {}
 
 type NoWarn struct{ NoLog }
 
+func (n NoWarn) With(...zap.Field) Logger {
+	return n
+}
+
 func (NoWarn) Fatal(string, ...zap.Field) {
 	panic("unexpected Fatal")
 }
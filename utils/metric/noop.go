@@ -0,0 +1,23 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metric
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// NoopRegisterer accepts every collector without exporting it. It can be used
+// by embedders that don't run a metrics stack. Collectors registered with it
+// remain safe to update.
+var NoopRegisterer prometheus.Registerer = noopRegisterer{}
+
+type noopRegisterer struct{}
+
+func (noopRegisterer) Register(prometheus.Collector) error {
+	return nil
+}
+
+func (noopRegisterer) MustRegister(...prometheus.Collector) {}
+
+func (noopRegisterer) Unregister(prometheus.Collector) bool {
+	return true
+}
@@ -0,0 +1,21 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoopRegisterer(t *testing.T) {
+	require := require.New(t)
+
+	// Registering the same metrics twice must not fail.
+	for i := 0; i < 2; i++ {
+		averager, err := NewAverager("", "test", "test", NoopRegisterer)
+		require.NoError(err)
+		averager.Observe(1)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metric
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterOrExistingCounter registers [c] with [registerer]. If an identical
+// counter was already registered, the existing counter is returned so that
+// both owners increment it rather than failing registration.
+//
+// Only counters may be shared this way: a gauge set by two owners reports
+// whichever value was set last.
+//
+// On any other error, [c] is returned along with the error. [c] is still safe
+// to use, but it won't be exported.
+func RegisterOrExistingCounter(registerer prometheus.Registerer, c prometheus.Counter) (prometheus.Counter, error) {
+	err := registerer.Register(c)
+	if err == nil {
+		return c, nil
+	}
+
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if errors.As(err, &alreadyRegistered) {
+		if existing, ok := alreadyRegistered.ExistingCollector.(prometheus.Counter); ok {
+			return existing, nil
+		}
+	}
+	return c, err
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metric
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterOrExistingCounter(t *testing.T) {
+	require := require.New(t)
+
+	registry := prometheus.NewRegistry()
+	newCounter := func(help string) prometheus.Counter {
+		return prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "counter",
+			Help: help,
+		})
+	}
+
+	first := newCounter("help")
+	registered, err := RegisterOrExistingCounter(registry, first)
+	require.NoError(err)
+	require.Equal(first, registered)
+
+	// An identical collector reuses the existing one.
+	registered, err = RegisterOrExistingCounter(registry, newCounter("help"))
+	require.NoError(err)
+	require.Equal(first, registered)
+
+	// A conflicting collector is returned unregistered along with the error.
+	conflicting := newCounter("different help")
+	registered, err = RegisterOrExistingCounter(registry, conflicting)
+	require.Error(err) //nolint:forbidigo // error is not exported https://github.com/prometheus/client_golang/blob/main/prometheus/registry.go#L315
+	require.Equal(conflicting, registered)
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+var (
+	ErrClosed            = errors.New("worker pool closed")
+	errNoWorkers         = errors.New("worker pool must have at least one worker")
+	errNegativeQueueSize = errors.New("worker pool queue size must be non-negative")
+)
+
+// Pool runs submitted tasks on a bounded number of goroutines.
+type Pool struct {
+	queueDepth    prometheus.Gauge
+	activeWorkers prometheus.Gauge
+
+	// closeLock prevents tasks from being submitted after [queue] is closed.
+	closeLock sync.RWMutex
+	closed    bool
+	queue     chan func()
+
+	// taskLock protects [numTasks]. Unlike a sync.WaitGroup, tasks may be
+	// submitted while Wait is blocked, even if no tasks are pending.
+	taskLock sync.Mutex
+	// idle is signalled when [numTasks] drops to 0.
+	idle *sync.Cond
+	// numTasks is the number of submitted tasks that haven't finished
+	// executing.
+	numTasks int
+	// workers tracks the worker goroutines.
+	workers sync.WaitGroup
+}
+
+// New returns a pool that runs tasks on [numWorkers] goroutines. At most
+// [queueSize] tasks may be waiting for a worker before Submit blocks.
+//
+// The pool reports its queue depth and number of busy workers to [registerer].
+func New(
+	namespace string,
+	numWorkers int,
+	queueSize int,
+	registerer prometheus.Registerer,
+) (*Pool, error) {
+	switch {
+	case numWorkers <= 0:
+		return nil, errNoWorkers
+	case queueSize < 0:
+		return nil, errNegativeQueueSize
+	}
+
+	p := &Pool{
+		queueDepth: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "queue_depth",
+			Help:      "Number of tasks waiting for a worker",
+		}),
+		activeWorkers: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "active_workers",
+			Help:      "Number of workers currently executing a task",
+		}),
+		queue: make(chan func(), queueSize),
+	}
+	p.idle = sync.NewCond(&p.taskLock)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		registerer.Register(p.queueDepth),
+		registerer.Register(p.activeWorkers),
+	)
+	if errs.Errored() {
+		return nil, errs.Err
+	}
+
+	p.workers.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go p.work()
+	}
+	return p, nil
+}
+
+func (p *Pool) work() {
+	defer p.workers.Done()
+
+	for task := range p.queue {
+		p.queueDepth.Dec()
+		p.activeWorkers.Inc()
+		task()
+		p.activeWorkers.Dec()
+		p.taskDone()
+	}
+}
+
+func (p *Pool) taskAdded() {
+	p.taskLock.Lock()
+	defer p.taskLock.Unlock()
+
+	p.numTasks++
+}
+
+func (p *Pool) taskDone() {
+	p.taskLock.Lock()
+	defer p.taskLock.Unlock()
+
+	p.numTasks--
+	if p.numTasks == 0 {
+		p.idle.Broadcast()
+	}
+}
+
+// Submit queues [task] to be executed by a worker. If the queue is full, Submit
+// blocks until there is room or [ctx] is cancelled.
+func (p *Pool) Submit(ctx context.Context, task func()) error {
+	p.closeLock.RLock()
+	defer p.closeLock.RUnlock()
+
+	if p.closed {
+		return ErrClosed
+	}
+
+	p.taskAdded()
+	p.queueDepth.Inc()
+	select {
+	case p.queue <- task:
+		return nil
+	case <-ctx.Done():
+		p.queueDepth.Dec()
+		p.taskDone()
+		return ctx.Err()
+	}
+}
+
+// Wait blocks until no submitted tasks are pending. Tasks submitted while Wait
+// is blocked are waited on as well.
+func (p *Pool) Wait() {
+	p.taskLock.Lock()
+	defer p.taskLock.Unlock()
+
+	for p.numTasks > 0 {
+		p.idle.Wait()
+	}
+}
+
+// Close stops accepting new tasks and blocks until all queued tasks have been
+// executed and the workers have exited. Close is idempotent.
+func (p *Pool) Close() {
+	p.closeLock.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.closeLock.Unlock()
+
+	p.workers.Wait()
+}
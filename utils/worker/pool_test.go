@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolRunsAllTasks(t *testing.T) {
+	require := require.New(t)
+
+	p, err := New("", 4, 8, prometheus.NewRegistry())
+	require.NoError(err)
+	defer p.Close()
+
+	const numTasks = 100
+	var ran atomic.Int64
+	for i := 0; i < numTasks; i++ {
+		require.NoError(p.Submit(context.Background(), func() {
+			ran.Add(1)
+		}))
+	}
+	p.Wait()
+
+	require.Equal(int64(numTasks), ran.Load())
+	require.Zero(testutil.ToFloat64(p.queueDepth))
+	require.Zero(testutil.ToFloat64(p.activeWorkers))
+}
+
+func TestPoolBoundsWorkers(t *testing.T) {
+	require := require.New(t)
+
+	p, err := New("", 1, 0, prometheus.NewRegistry())
+	require.NoError(err)
+	defer p.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	require.NoError(p.Submit(context.Background(), func() {
+		close(started)
+		<-release
+	}))
+	<-started
+	require.Equal(float64(1), testutil.ToFloat64(p.activeWorkers))
+
+	// The only worker is busy and there is no queue, so submitting must block
+	// until the context is cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = p.Submit(ctx, func() {})
+	require.ErrorIs(err, context.Canceled)
+	require.Zero(testutil.ToFloat64(p.queueDepth))
+
+	close(release)
+	p.Wait()
+}
+
+func TestPoolClose(t *testing.T) {
+	require := require.New(t)
+
+	p, err := New("", 2, 4, prometheus.NewRegistry())
+	require.NoError(err)
+
+	var ran atomic.Int64
+	for i := 0; i < 4; i++ {
+		require.NoError(p.Submit(context.Background(), func() {
+			ran.Add(1)
+		}))
+	}
+
+	p.Close()
+	require.Equal(int64(4), ran.Load())
+
+	err = p.Submit(context.Background(), func() {})
+	require.ErrorIs(err, ErrClosed)
+
+	p.Close()
+}
+
+func TestNewInvalidConfig(t *testing.T) {
+	require := require.New(t)
+
+	_, err := New("", 0, 1, prometheus.NewRegistry())
+	require.ErrorIs(err, errNoWorkers)
+
+	_, err = New("", 1, -1, prometheus.NewRegistry())
+	require.ErrorIs(err, errNegativeQueueSize)
+}
+
+func TestPoolSubmitDuringWait(t *testing.T) {
+	require := require.New(t)
+
+	p, err := New("", 2, 0, prometheus.NewRegistry())
+	require.NoError(err)
+	defer p.Close()
+
+	// Waiters and submitters race while the number of pending tasks repeatedly
+	// drops to zero.
+	const (
+		numSubmitters = 4
+		numTasks      = 100
+	)
+	var (
+		ran        atomic.Int64
+		submitters sync.WaitGroup
+		waiters    sync.WaitGroup
+		done       = make(chan struct{})
+	)
+	waiters.Add(1)
+	go func() {
+		defer waiters.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				p.Wait()
+			}
+		}
+	}()
+
+	submitters.Add(numSubmitters)
+	for i := 0; i < numSubmitters; i++ {
+		go func() {
+			defer submitters.Done()
+			for j := 0; j < numTasks; j++ {
+				_ = p.Submit(context.Background(), func() {
+					ran.Add(1)
+				})
+			}
+		}()
+	}
+	submitters.Wait()
+	close(done)
+	waiters.Wait()
+
+	p.Wait()
+	require.Equal(int64(numSubmitters*numTasks), ran.Load())
+}
@@ -3,19 +3,52 @@
 
 package wrappers
 
-type Errs struct{ Err error }
+import "slices"
+
+type Errs struct {
+	// Err is the first non-nil error that was added.
+	Err error
+
+	// CollectAll, if true, records every non-nil error passed to Add rather
+	// than only the first one.
+	CollectAll bool
+
+	errs []error
+}
 
 func (errs *Errs) Errored() bool {
 	return errs.Err != nil
 }
 
 func (errs *Errs) Add(errors ...error) {
-	if errs.Err == nil {
-		for _, err := range errors {
-			if err != nil {
-				errs.Err = err
-				break
+	for _, err := range errors {
+		if err == nil {
+			continue
+		}
+		if errs.CollectAll {
+			// Err may have been set directly rather than through Add.
+			if errs.Err != nil && len(errs.errs) == 0 {
+				errs.errs = append(errs.errs, errs.Err)
 			}
+			errs.errs = append(errs.errs, err)
 		}
+		if errs.Err == nil {
+			errs.Err = err
+		} else if !errs.CollectAll {
+			return
+		}
+	}
+}
+
+// Errors returns the recorded errors in the order they were added. Unless
+// [CollectAll] is set, this contains at most the first error.
+func (errs *Errs) Errors() []error {
+	switch {
+	case errs.Err == nil:
+		return nil
+	case len(errs.errs) == 0:
+		return []error{errs.Err}
+	default:
+		return slices.Clone(errs.errs)
 	}
 }
@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package wrappers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errTest1 = errors.New("test error 1")
+	errTest2 = errors.New("test error 2")
+	errTest3 = errors.New("test error 3")
+)
+
+func TestErrs(t *testing.T) {
+	tests := []struct {
+		name           string
+		collectAll     bool
+		expectedErrors []error
+	}{
+		{
+			name:           "first error only",
+			collectAll:     false,
+			expectedErrors: []error{errTest1},
+		},
+		{
+			name:           "collect all errors",
+			collectAll:     true,
+			expectedErrors: []error{errTest1, errTest2, errTest3},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			errs := Errs{CollectAll: test.collectAll}
+			require.False(errs.Errored())
+			require.Empty(errs.Errors())
+
+			errs.Add(nil, errTest1, nil, errTest2)
+			errs.Add(errTest3)
+
+			require.True(errs.Errored())
+			require.ErrorIs(errs.Err, errTest1)
+			require.Equal(test.expectedErrors, errs.Errors())
+
+			// Modifying the returned errors doesn't modify [errs].
+			errs.Errors()[0] = nil
+			require.Equal(test.expectedErrors, errs.Errors())
+		})
+	}
+}
@@ -526,13 +526,13 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 		Codec: parser.Codec(),
 	}
 
+	onAccept := func(*txs.Tx, state.ReadOnlyChain) error { return nil }
 	baseDB := versiondb.New(memdb.New())
 
 	state, err := state.New(baseDB, parser, registerer, trackChecksums)
 	require.NoError(err)
 
 	clk := &mockable.Clock{}
-	onAccept := func(*txs.Tx) error { return nil }
 	now := time.Now()
 	parentTimestamp := now.Add(-2 * time.Second)
 	parentID := ids.GenerateTestID()
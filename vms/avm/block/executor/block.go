@@ -208,9 +208,14 @@ func (b *Block) Accept(context.Context) error {
 	blkID := b.ID()
 	defer b.manager.free(blkID)
 
+	blkState, ok := b.manager.blkIDToState[blkID]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrBlockNotFound, blkID)
+	}
+
 	txs := b.Txs()
 	for _, tx := range txs {
-		if err := b.manager.onAccept(tx); err != nil {
+		if err := b.manager.onAccept(tx, blkState.onAcceptState); err != nil {
 			return fmt.Errorf(
 				"failed to mark tx %q as accepted: %w",
 				blkID,
@@ -222,11 +227,6 @@ func (b *Block) Accept(context.Context) error {
 	b.manager.lastAccepted = blkID
 	b.manager.mempool.Remove(txs...)
 
-	blkState, ok := b.manager.blkIDToState[blkID]
-	if !ok {
-		return fmt.Errorf("%w: %s", ErrBlockNotFound, blkID)
-	}
-
 	// Update the state to reflect the changes made in [onAcceptState].
 	blkState.onAcceptState.Apply(b.manager.state)
 
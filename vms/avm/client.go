@@ -43,6 +43,9 @@ type Client interface {
 	ConfirmTx(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (choices.Status, error)
 	// GetTx returns the byte representation of [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
+	// GetTxBlock returns the ID and height of the accepted block that included
+	// [txID] along with the index of the tx within that block
+	GetTxBlock(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxBlockReply, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
 	GetUTXOs(
 		ctx context.Context,
@@ -52,6 +55,24 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetAssetUTXOs returns the byte representation of the unspent UTXOs of
+	// [assetID], along with the ID of the last UTXO returned
+	GetAssetUTXOs(
+		ctx context.Context,
+		assetID string,
+		limit uint32,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([][]byte, ids.ID, error)
+	// GetAssetMints returns the mint operations of [assetID] starting at
+	// [cursor] along with the total amount of the asset that has been minted
+	GetAssetMints(
+		ctx context.Context,
+		assetID string,
+		cursor uint64,
+		pageSize uint64,
+		options ...rpc.Option,
+	) (*GetAssetMintsReply, error)
 	// GetAtomicUTXOs returns the byte representation of the atomic UTXOs controlled by [addrs]
 	// from [sourceChain]
 	GetAtomicUTXOs(
@@ -317,6 +338,14 @@ func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option)
 	return formatting.Decode(res.Encoding, res.Tx)
 }
 
+func (c *client) GetTxBlock(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxBlockReply, error) {
+	res := &GetTxBlockReply{}
+	err := c.requester.SendRequest(ctx, "avm.getTxBlock", &api.JSONTxID{
+		TxID: txID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetUTXOs(
 	ctx context.Context,
 	addrs []ids.ShortID,
@@ -368,6 +397,52 @@ func (c *client) GetAtomicUTXOs(
 	return utxos, endAddr, endUTXOID, err
 }
 
+func (c *client) GetAssetUTXOs(
+	ctx context.Context,
+	assetID string,
+	limit uint32,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) ([][]byte, ids.ID, error) {
+	res := &GetAssetUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getAssetUTXOs", &GetAssetUTXOsArgs{
+		AssetID:   assetID,
+		Limit:     json.Uint32(limit),
+		StartUTXO: startUTXOID.String(),
+		Encoding:  formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, ids.Empty, err
+	}
+
+	utxos := make([][]byte, len(res.UTXOs))
+	for i, utxo := range res.UTXOs {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+		if err != nil {
+			return nil, ids.Empty, err
+		}
+		utxos[i] = utxoBytes
+	}
+	endUTXOID, err := ids.FromString(res.EndUTXO)
+	return utxos, endUTXOID, err
+}
+
+func (c *client) GetAssetMints(
+	ctx context.Context,
+	assetID string,
+	cursor uint64,
+	pageSize uint64,
+	options ...rpc.Option,
+) (*GetAssetMintsReply, error) {
+	res := &GetAssetMintsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getAssetMints", &GetAssetMintsArgs{
+		AssetID:  assetID,
+		Cursor:   json.Uint64(cursor),
+		PageSize: json.Uint64(pageSize),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error) {
 	res := &GetAssetDescriptionReply{}
 	err := c.requester.SendRequest(ctx, "avm.getAssetDescription", &GetAssetDescriptionArgs{
@@ -5,14 +5,18 @@ package avm
 
 import (
 	"encoding/json"
+	"errors"
 
 	"github.com/ava-labs/avalanchego/vms/avm/network"
 )
 
+var errIndexBackfillWithoutIndexing = errors.New("index-backfill requires index-transactions")
+
 var DefaultConfig = Config{
 	Network:              network.DefaultConfig,
 	IndexTransactions:    false,
 	IndexAllowIncomplete: false,
+	IndexBackfill:        false,
 	ChecksumsEnabled:     false,
 }
 
@@ -20,6 +24,7 @@ type Config struct {
 	Network              network.Config `json:"network"`
 	IndexTransactions    bool           `json:"index-transactions"`
 	IndexAllowIncomplete bool           `json:"index-allow-incomplete"`
+	IndexBackfill        bool           `json:"index-backfill"`
 	ChecksumsEnabled     bool           `json:"checksums-enabled"`
 }
 
@@ -29,6 +34,11 @@ func ParseConfig(configBytes []byte) (Config, error) {
 	}
 
 	config := DefaultConfig
-	err := json.Unmarshal(configBytes, &config)
-	return config, err
+	if err := json.Unmarshal(configBytes, &config); err != nil {
+		return Config{}, err
+	}
+	if config.IndexBackfill && !config.IndexTransactions {
+		return Config{}, errIndexBackfillWithoutIndexing
+	}
+	return config, nil
 }
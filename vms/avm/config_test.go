@@ -72,3 +72,8 @@ func TestParseConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParseConfigIndexBackfillWithoutIndexing(t *testing.T) {
+	_, err := ParseConfig([]byte(`{"index-backfill":true}`))
+	require.ErrorIs(t, err, errIndexBackfillWithoutIndexing)
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/vms/avm/block"
+	"github.com/ava-labs/avalanchego/vms/avm/state"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/index"
+)
+
+// dagTxsKey maps to true if txs may have been accepted before the chain was
+// linearized.
+var dagTxsKey = []byte("dagTxs")
+
+// recordDAGTxs records whether txs may have been accepted before the chain was
+// linearized. If the chain was linearized before this was tracked, it is
+// assumed that txs were accepted.
+func (vm *VM) recordDAGTxs() error {
+	has, err := vm.db.Has(dagTxsKey)
+	if err != nil || has {
+		return err
+	}
+
+	lastAccepted, err := vm.getLastAcceptedBlock()
+	if err != nil {
+		return err
+	}
+	if err := database.PutBool(vm.db, dagTxsKey, lastAccepted.Height() != 0); err != nil {
+		return err
+	}
+	return vm.db.Commit()
+}
+
+// recordFirstIndexedHeight records the height of the first block that will be
+// indexed as it is accepted, if no such height has been recorded yet.
+func (vm *VM) recordFirstIndexedHeight() error {
+	height, err := index.GetFirstIndexedHeight(vm.db)
+	if err != nil || height != 0 {
+		return err
+	}
+
+	lastAccepted, err := vm.getLastAcceptedBlock()
+	if err != nil {
+		return err
+	}
+	if err := index.PutFirstIndexedHeight(vm.db, lastAccepted.Height()+1); err != nil {
+		return err
+	}
+	return vm.db.Commit()
+}
+
+// backfillIndex replays the txs of accepted blocks into the address tx index.
+// Progress is committed after every block so that an interrupted backfill
+// resumes where it stopped. The backfill stops at the first block that was
+// indexed as it was accepted.
+//
+// Txs accepted before the chain was linearized aren't stored in acceptance
+// order, so they can't be replayed. If any may exist, the index is left
+// incomplete.
+func (vm *VM) backfillIndex() error {
+	complete, err := index.IsComplete(vm.db)
+	if err != nil {
+		return err
+	}
+	if complete {
+		return nil
+	}
+
+	lastAccepted, err := vm.getLastAcceptedBlock()
+	if err != nil {
+		return err
+	}
+	firstIndexedHeight, err := index.GetFirstIndexedHeight(vm.db)
+	if err != nil {
+		return err
+	}
+
+	// The genesis block never contains txs, so the backfill starts at height 1.
+	nextHeight, err := index.GetBackfillHeight(vm.db)
+	if err != nil {
+		return err
+	}
+	nextHeight = max(nextHeight, 1)
+
+	// A previous backfill already replayed every block it could. The index
+	// wasn't marked complete because txs were accepted before the chain was
+	// linearized.
+	if nextHeight >= firstIndexedHeight {
+		vm.ctx.Log.Debug("address transaction index backfill already complete",
+			zap.Uint64("height", firstIndexedHeight-1),
+		)
+		return nil
+	}
+
+	// Replaying blocks after txs were indexed as they were accepted would
+	// place older txs after newer ones.
+	lastAcceptedHeight := lastAccepted.Height()
+	if firstIndexedHeight <= lastAcceptedHeight {
+		vm.ctx.Log.Warn("skipping address transaction index backfill",
+			zap.String("reason", "blocks were indexed before the backfill"),
+			zap.Uint64("firstIndexedHeight", firstIndexedHeight),
+			zap.Uint64("lastAcceptedHeight", lastAcceptedHeight),
+		)
+		return nil
+	}
+
+	vm.ctx.Log.Info("backfilling address transaction index",
+		zap.Uint64("startHeight", nextHeight),
+		zap.Uint64("endHeight", firstIndexedHeight-1),
+	)
+	for height := nextHeight; height < firstIndexedHeight; height++ {
+		blkID, err := vm.state.GetBlockIDAtHeight(height)
+		if err != nil {
+			return fmt.Errorf("failed to get block ID at height %d: %w", height, err)
+		}
+		blk, err := vm.state.GetBlock(blkID)
+		if err != nil {
+			return fmt.Errorf("failed to get block %s: %w", blkID, err)
+		}
+
+		for _, tx := range blk.Txs() {
+			if err := vm.indexTx(tx, vm.getAcceptedUTXO); err != nil {
+				return err
+			}
+		}
+
+		if err := index.PutBackfillHeight(vm.db, height+1); err != nil {
+			return err
+		}
+		if err := vm.db.Commit(); err != nil {
+			return err
+		}
+	}
+
+	dagTxs, err := database.GetBool(vm.db, dagTxsKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	if dagTxs || err == database.ErrNotFound {
+		vm.ctx.Log.Info("address transaction index backfill complete",
+			zap.Uint64("height", firstIndexedHeight-1),
+			zap.String("reason", "txs accepted before linearization aren't indexed"),
+		)
+		return nil
+	}
+
+	vm.ctx.Log.Info("address transaction index backfill complete",
+		zap.Uint64("height", firstIndexedHeight-1),
+	)
+	if err := index.MarkComplete(vm.db); err != nil {
+		return err
+	}
+	return vm.db.Commit()
+}
+
+func (vm *VM) getLastAcceptedBlock() (block.Block, error) {
+	lastAcceptedID := vm.state.GetLastAccepted()
+	lastAccepted, err := vm.state.GetBlock(lastAcceptedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last accepted block %s: %w", lastAcceptedID, err)
+	}
+	return lastAccepted, nil
+}
+
+// getAcceptedUTXO returns the UTXO as it was produced by its accepted tx. This
+// allows fetching UTXOs that have since been consumed.
+func (vm *VM) getAcceptedUTXO(utxoID *avax.UTXOID) (*avax.UTXO, error) {
+	return getProducedUTXO(vm.state, utxoID)
+}
+
+// getProducedUTXO returns the UTXO as it was produced by its tx in [chain].
+func getProducedUTXO(chain state.ReadOnlyChain, utxoID *avax.UTXOID) (*avax.UTXO, error) {
+	tx, err := chain.GetTx(utxoID.TxID)
+	if err != nil {
+		return nil, err
+	}
+	for _, utxo := range tx.UTXOs() {
+		if utxo.OutputIndex == utxoID.OutputIndex {
+			return utxo, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
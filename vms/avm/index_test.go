@@ -19,6 +19,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/avm/block"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/index"
@@ -254,6 +256,310 @@ func TestIndexingAllowIncomplete(t *testing.T) {
 	require.ErrorIs(err, index.ErrIndexingRequiredFromGenesis)
 }
 
+func TestAssetUTXOsIndexIncomplete(t *testing.T) {
+	require := require.New(t)
+
+	db := versiondb.New(memdb.New())
+	assetID := ids.GenerateTestID()
+
+	// An index created after the chain was initialized is missing the UTXOs
+	// produced before it existed.
+	indexer, err := index.NewAssetUTXOsIndexer(db, true, false)
+	require.NoError(err)
+	_, err = indexer.Read(assetID, ids.Empty, 1)
+	require.ErrorIs(err, index.ErrIncompleteAssetUTXOsIndex)
+
+	indexer, err = index.NewAssetUTXOsIndexer(db, true, true)
+	require.NoError(err)
+	_, err = indexer.Read(assetID, ids.Empty, 1)
+	require.NoError(err)
+
+	// Running without indexing makes a complete index incomplete.
+	db = versiondb.New(memdb.New())
+	indexer, err = index.NewAssetUTXOsIndexer(db, false, false)
+	require.NoError(err)
+	_, err = indexer.Read(assetID, ids.Empty, 1)
+	require.NoError(err)
+
+	_, err = index.NewNoAssetUTXOsIndexer(db)
+	require.NoError(err)
+
+	indexer, err = index.NewAssetUTXOsIndexer(db, true, false)
+	require.NoError(err)
+	_, err = indexer.Read(assetID, ids.Empty, 1)
+	require.ErrorIs(err, index.ErrIncompleteAssetUTXOsIndex)
+}
+
+func TestMintIndexIncomplete(t *testing.T) {
+	require := require.New(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{&secp256k1fx.Fx{}})
+	require.NoError(err)
+	codec := parser.Codec()
+
+	db := versiondb.New(memdb.New())
+	assetID := ids.GenerateTestID()
+
+	// An index created after the chain was initialized is missing the mints
+	// accepted before it existed.
+	indexer, err := newMintIndex(db, codec, prometheus.NewRegistry(), true, false)
+	require.NoError(err)
+	_, err = indexer.Read(assetID, 0, 1)
+	require.ErrorIs(err, errIncompleteMintIndex)
+	_, err = indexer.Total(assetID)
+	require.ErrorIs(err, errIncompleteMintIndex)
+
+	indexer, err = newMintIndex(db, codec, prometheus.NewRegistry(), true, true)
+	require.NoError(err)
+	_, err = indexer.Total(assetID)
+	require.NoError(err)
+
+	// Running without indexing makes a complete index incomplete.
+	db = versiondb.New(memdb.New())
+	indexer, err = newMintIndex(db, codec, prometheus.NewRegistry(), false, false)
+	require.NoError(err)
+	_, err = indexer.Total(assetID)
+	require.NoError(err)
+
+	_, err = newNoMintIndex(db)
+	require.NoError(err)
+
+	indexer, err = newMintIndex(db, codec, prometheus.NewRegistry(), true, false)
+	require.NoError(err)
+	_, err = indexer.Total(assetID)
+	require.ErrorIs(err, errIncompleteMintIndex)
+}
+
+func TestAssetUTXOsIndexDependentTxs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		vmStaticConfig: noFeesTestConfig,
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	key := keys[0]
+	addr := key.PublicKey().Address()
+	txAssetID := avax.Asset{ID: env.genesisTx.ID()}
+
+	utxoID := avax.UTXOID{
+		TxID: ids.GenerateTestID(),
+	}
+	env.vm.state.AddUTXO(buildUTXO(utxoID, txAssetID, addr))
+
+	// [childTx] spends the output of [parentTx] in the same block.
+	parentTx := buildTX(env.vm.ctx.XChainID, utxoID, txAssetID, addr)
+	require.NoError(parentTx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+	parentUTXOID := avax.UTXOID{
+		TxID: parentTx.ID(),
+	}
+	childTx := buildTX(env.vm.ctx.XChainID, parentUTXOID, txAssetID, addr)
+	require.NoError(childTx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+
+	lastAccepted, err := env.vm.state.GetBlock(env.vm.state.GetLastAccepted())
+	require.NoError(err)
+	blk, err := block.NewStandardBlock(
+		lastAccepted.ID(),
+		lastAccepted.Height()+1,
+		lastAccepted.Timestamp(),
+		[]*txs.Tx{parentTx, childTx},
+		env.vm.parser.Codec(),
+	)
+	require.NoError(err)
+
+	parsedBlk, err := env.vm.ParseBlock(context.Background(), blk.Bytes())
+	require.NoError(err)
+	require.NoError(parsedBlk.Verify(context.Background()))
+	require.NoError(parsedBlk.Accept(context.Background()))
+
+	// Page through the index, starting after the spent output of [parentTx].
+	spentUTXOID := parentUTXOID.InputID()
+	var (
+		utxoIDs []ids.ID
+		start   = spentUTXOID
+	)
+	for {
+		page, err := env.vm.assetUTXOsIndexer.Read(txAssetID.ID, start, 1)
+		require.NoError(err)
+		if len(page) == 0 {
+			break
+		}
+		require.Positive(page[0].Compare(start))
+		utxoIDs = append(utxoIDs, page...)
+		start = page[0]
+	}
+
+	allUTXOIDs, err := env.vm.assetUTXOsIndexer.Read(txAssetID.ID, ids.Empty, len(utxoIDs)+64)
+	require.NoError(err)
+	require.NotContains(allUTXOIDs, utxoID.InputID())
+	require.NotContains(allUTXOIDs, spentUTXOID)
+
+	childUTXOID := avax.UTXOID{
+		TxID: childTx.ID(),
+	}
+	require.Contains(allUTXOIDs, childUTXOID.InputID())
+
+	var expectedUTXOIDs []ids.ID
+	for _, utxoID := range allUTXOIDs {
+		if utxoID.Compare(spentUTXOID) > 0 {
+			expectedUTXOIDs = append(expectedUTXOIDs, utxoID)
+		}
+	}
+	require.Equal(expectedUTXOIDs, utxoIDs)
+}
+
+func TestIndexBackfill(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.IndexTransactions = false
+	env := setup(t, &envConfig{
+		vmStaticConfig:  noFeesTestConfig,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	key := keys[0]
+	addr := key.PublicKey().Address()
+	txAssetID := avax.Asset{ID: env.genesisTx.ID()}
+
+	// Accept txs while indexing is disabled.
+	var txIDs []ids.ID
+	for i := 0; i < 3; i++ {
+		utxoID := avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		}
+		utxo := buildUTXO(utxoID, txAssetID, addr)
+		env.vm.state.AddUTXO(utxo)
+
+		tx := buildTX(env.vm.ctx.XChainID, utxoID, txAssetID, addr)
+		require.NoError(tx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+
+		env.vm.ctx.Lock.Unlock()
+		issueAndAccept(require, env.vm, env.issuer, tx)
+		env.vm.ctx.Lock.Lock()
+
+		txIDs = append(txIDs, tx.ID())
+	}
+
+	complete, err := index.IsComplete(env.vm.db)
+	require.NoError(err)
+	require.False(complete)
+
+	// Enable indexing and backfill the previously accepted txs.
+	env.vm.addressTxsIndexer, err = index.NewIndexer(env.vm.db, logging.NoWarn{}, "", prometheus.NewRegistry(), true)
+	require.NoError(err)
+	require.NoError(env.vm.recordFirstIndexedHeight())
+	require.NoError(env.vm.backfillIndex())
+
+	for i, txID := range txIDs {
+		assertIndexedTX(t, env.vm.db, uint64(i), addr, txAssetID.ID, txID)
+	}
+	assertLatestIdx(t, env.vm.db, addr, txAssetID.ID, uint64(len(txIDs)))
+
+	complete, err = index.IsComplete(env.vm.db)
+	require.NoError(err)
+	require.True(complete)
+
+	// A complete index isn't backfilled again.
+	require.NoError(env.vm.backfillIndex())
+	assertLatestIdx(t, env.vm.db, addr, txAssetID.ID, uint64(len(txIDs)))
+}
+
+func TestIndexBackfillAfterLiveIndexing(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.IndexTransactions = false
+	env := setup(t, &envConfig{
+		vmStaticConfig:  noFeesTestConfig,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	key := keys[0]
+	addr := key.PublicKey().Address()
+	txAssetID := avax.Asset{ID: env.genesisTx.ID()}
+
+	issueTx := func() ids.ID {
+		utxoID := avax.UTXOID{
+			TxID: ids.GenerateTestID(),
+		}
+		utxo := buildUTXO(utxoID, txAssetID, addr)
+		env.vm.state.AddUTXO(utxo)
+
+		tx := buildTX(env.vm.ctx.XChainID, utxoID, txAssetID, addr)
+		require.NoError(tx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+
+		env.vm.ctx.Lock.Unlock()
+		issueAndAccept(require, env.vm, env.issuer, tx)
+		env.vm.ctx.Lock.Lock()
+		return tx.ID()
+	}
+
+	// Accept a tx while indexing is disabled.
+	issueTx()
+
+	// Enable indexing without a backfill and accept a tx.
+	var err error
+	env.vm.addressTxsIndexer, err = index.NewIndexer(env.vm.db, logging.NoWarn{}, "", prometheus.NewRegistry(), true)
+	require.NoError(err)
+	require.NoError(env.vm.recordFirstIndexedHeight())
+	liveTxID := issueTx()
+
+	firstIndexedHeight, err := index.GetFirstIndexedHeight(env.vm.db)
+	require.NoError(err)
+	require.Equal(uint64(2), firstIndexedHeight)
+
+	// The backfill must not index the earlier tx after the live indexed tx or
+	// index the live indexed tx again.
+	require.NoError(env.vm.backfillIndex())
+	assertIndexedTX(t, env.vm.db, 0, addr, txAssetID.ID, liveTxID)
+	assertLatestIdx(t, env.vm.db, addr, txAssetID.ID, 1)
+
+	complete, err := index.IsComplete(env.vm.db)
+	require.NoError(err)
+	require.False(complete)
+}
+
+func TestIndexBackfillDAGTxs(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.IndexTransactions = false
+	env := setup(t, &envConfig{
+		vmStaticConfig:  noFeesTestConfig,
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	// Txs accepted before linearization can't be replayed, so the backfill
+	// must not mark the index as complete.
+	require.NoError(database.PutBool(env.vm.db, dagTxsKey, true))
+
+	var err error
+	env.vm.addressTxsIndexer, err = index.NewIndexer(env.vm.db, logging.NoWarn{}, "", prometheus.NewRegistry(), true)
+	require.NoError(err)
+	require.NoError(env.vm.recordFirstIndexedHeight())
+	require.NoError(env.vm.backfillIndex())
+
+	complete, err := index.IsComplete(env.vm.db)
+	require.NoError(err)
+	require.False(complete)
+}
+
 func buildUTXO(utxoID avax.UTXOID, txAssetID avax.Asset, addr ids.ShortID) *avax.UTXO {
 	return &avax.UTXO{
 		UTXOID: utxoID,
@@ -0,0 +1,227 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/index"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var (
+	errMintIndexingDisabled = errors.New("mint indexing is disabled")
+	errIncompleteMintIndex  = errors.New("mint index is incomplete. Allow incomplete indices or re-sync from genesis with indexing enabled")
+
+	mintsPrefix   = []byte("mints")
+	mintIdxKey    = []byte("idx")
+	mintTotalKey  = []byte("total")
+	mintRecordKey = []byte("record")
+
+	_ mintIndexer = (*mintIndex)(nil)
+	_ mintIndexer = (*noMintIndex)(nil)
+)
+
+// mintRecord is a single secp256k1fx mint operation of an asset.
+type mintRecord struct {
+	TxID   ids.ID                   `serialize:"true"`
+	Amount uint64                   `serialize:"true"`
+	Owners secp256k1fx.OutputOwners `serialize:"true"`
+}
+
+// mintIndexer maintains, for each asset, the ordered log of the secp256k1fx
+// mint operations accepted on the chain.
+type mintIndexer interface {
+	// Accept records the mint operations performed by [tx].
+	Accept(tx *txs.Tx) error
+
+	// Read returns the mint operations of [assetID] in order of acceptance,
+	// starting at [cursor]. Returns at most [pageSize] records.
+	Read(assetID ids.ID, cursor, pageSize uint64) ([]mintRecord, error)
+
+	// Total returns the total amount of [assetID] that has been minted.
+	Total(assetID ids.ID) (uint64, error)
+}
+
+// The database structure is:
+// [assetID]
+// |  "idx"    => 2      Running mint index, represents the next index
+// |  "total"  => amount Total amount minted
+// |  "record"
+// |  |  0 => mintRecord
+// |  |  1 => mintRecord
+type mintIndex struct {
+	db    database.Database
+	codec codec.Manager
+
+	complete        bool
+	allowIncomplete bool
+
+	// number of mint operations indexed since the node started
+	mints prometheus.Counter
+}
+
+// newMintIndex returns a new mintIndex.
+//
+// If the index has never been initialized, it is only considered complete if
+// [chainInitialized] is false. Reads from an incomplete index fail unless
+// [allowIncomplete] is true.
+func newMintIndex(
+	db database.Database,
+	codec codec.Manager,
+	registerer prometheus.Registerer,
+	chainInitialized bool,
+	allowIncomplete bool,
+) (*mintIndex, error) {
+	db = prefixdb.New(mintsPrefix, db)
+	complete, err := index.InitCompleteness(db, chainInitialized)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &mintIndex{
+		db:              db,
+		codec:           codec,
+		complete:        complete,
+		allowIncomplete: allowIncomplete,
+		mints: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "mint_operations",
+			Help: "Number of secp256k1fx mint operations indexed",
+		}),
+	}
+	return i, registerer.Register(i.mints)
+}
+
+func (i *mintIndex) Accept(tx *txs.Tx) error {
+	opTx, ok := tx.Unsigned.(*txs.OperationTx)
+	if !ok {
+		return nil
+	}
+
+	txID := tx.ID()
+	for _, op := range opTx.Ops {
+		mintOp, ok := op.Op.(*secp256k1fx.MintOperation)
+		if !ok {
+			continue
+		}
+
+		assetID := op.AssetID()
+		record := &mintRecord{
+			TxID:   txID,
+			Amount: mintOp.TransferOutput.Amt,
+			Owners: mintOp.TransferOutput.OutputOwners,
+		}
+		if err := i.put(assetID, record); err != nil {
+			return fmt.Errorf("failed to index mint of asset %s in tx %s: %w", assetID, txID, err)
+		}
+		i.mints.Inc()
+	}
+	return nil
+}
+
+func (i *mintIndex) put(assetID ids.ID, record *mintRecord) error {
+	assetDB := prefixdb.New(assetID[:], i.db)
+
+	idx, err := getUInt64(assetDB, mintIdxKey)
+	if err != nil {
+		return err
+	}
+	total, err := getUInt64(assetDB, mintTotalKey)
+	if err != nil {
+		return err
+	}
+
+	recordBytes, err := i.codec.Marshal(txs.CodecVersion, record)
+	if err != nil {
+		return err
+	}
+
+	recordDB := prefixdb.New(mintRecordKey, assetDB)
+	if err := recordDB.Put(database.PackUInt64(idx), recordBytes); err != nil {
+		return err
+	}
+	if err := database.PutUInt64(assetDB, mintIdxKey, idx+1); err != nil {
+		return err
+	}
+
+	// Nothing prevents an asset from being minted past MaxUint64 over multiple
+	// txs, so the total saturates rather than halting acceptance.
+	total, err = safemath.Add64(total, record.Amount)
+	if err != nil {
+		total = math.MaxUint64
+	}
+	return database.PutUInt64(assetDB, mintTotalKey, total)
+}
+
+func (i *mintIndex) Read(assetID ids.ID, cursor, pageSize uint64) ([]mintRecord, error) {
+	if !i.complete && !i.allowIncomplete {
+		return nil, errIncompleteMintIndex
+	}
+
+	assetDB := prefixdb.New(assetID[:], i.db)
+	recordDB := prefixdb.New(mintRecordKey, assetDB)
+
+	iter := recordDB.NewIteratorWithStart(database.PackUInt64(cursor))
+	defer iter.Release()
+
+	var records []mintRecord
+	for uint64(len(records)) < pageSize && iter.Next() {
+		var record mintRecord
+		if _, err := i.codec.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, iter.Error()
+}
+
+func (i *mintIndex) Total(assetID ids.ID) (uint64, error) {
+	if !i.complete && !i.allowIncomplete {
+		return 0, errIncompleteMintIndex
+	}
+
+	assetDB := prefixdb.New(assetID[:], i.db)
+	return getUInt64(assetDB, mintTotalKey)
+}
+
+// getUInt64 returns the value of [key], or 0 if it doesn't exist.
+func getUInt64(db database.KeyValueReader, key []byte) (uint64, error) {
+	val, err := database.GetUInt64(db, key)
+	if err == database.ErrNotFound {
+		return 0, nil
+	}
+	return val, err
+}
+
+type noMintIndex struct{}
+
+// newNoMintIndex returns a mintIndexer that doesn't index anything. Running
+// with it marks any existing index as incomplete.
+func newNoMintIndex(db database.Database) (*noMintIndex, error) {
+	db = prefixdb.New(mintsPrefix, db)
+	return &noMintIndex{}, index.MarkIncomplete(db)
+}
+
+func (*noMintIndex) Accept(*txs.Tx) error {
+	return nil
+}
+
+func (*noMintIndex) Read(ids.ID, uint64, uint64) ([]mintRecord, error) {
+	return nil, errMintIndexingDisabled
+}
+
+func (*noMintIndex) Total(ids.ID) (uint64, error) {
+	return 0, errMintIndexingDisabled
+}
@@ -296,6 +296,87 @@ func (s *Service) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply
 	return nil
 }
 
+type GetAssetMintsArgs struct {
+	AssetID string `json:"assetID"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+}
+
+// Mint is a single mint operation of an asset
+type Mint struct {
+	TxID      ids.ID         `json:"txID"`
+	Amount    avajson.Uint64 `json:"amount"`
+	Locktime  avajson.Uint64 `json:"locktime"`
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
+}
+
+type GetAssetMintsReply struct {
+	Mints []Mint `json:"mints"`
+	// TotalMinted is the amount minted across all of the asset's mints
+	TotalMinted avajson.Uint64 `json:"totalMinted"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetAssetMints returns the mint operations of an asset in order of acceptance
+func (s *Service) GetAssetMints(_ *http.Request, args *GetAssetMintsArgs, reply *GetAssetMintsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAssetMints"),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return fmt.Errorf("specified `assetID` is invalid: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	records, err := s.vm.mintIndexer.Read(assetID, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+	totalMinted, err := s.vm.mintIndexer.Total(assetID)
+	if err != nil {
+		return err
+	}
+
+	reply.Mints = make([]Mint, len(records))
+	for i, record := range records {
+		addrs := make([]string, len(record.Owners.Addrs))
+		for j, addr := range record.Owners.Addrs {
+			addrs[j], err = s.vm.FormatLocalAddress(addr)
+			if err != nil {
+				return fmt.Errorf("problem formatting address: %w", err)
+			}
+		}
+		reply.Mints[i] = Mint{
+			TxID:      record.TxID,
+			Amount:    avajson.Uint64(record.Amount),
+			Locktime:  avajson.Uint64(record.Owners.Locktime),
+			Threshold: avajson.Uint32(record.Owners.Threshold),
+			Addresses: addrs,
+		}
+	}
+	reply.TotalMinted = avajson.Uint64(totalMinted)
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(records)))
+	return nil
+}
+
 // GetTxStatus returns the status of the specified transaction
 //
 // Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
@@ -367,6 +448,44 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxRe
 	return err
 }
 
+// GetTxBlockReply defines the GetTxBlock replies returned from the API
+type GetTxBlockReply struct {
+	BlockID ids.ID         `json:"blockID"`
+	Height  avajson.Uint64 `json:"height"`
+	Index   avajson.Uint32 `json:"index"`
+}
+
+// GetTxBlock returns the accepted block that included the specified tx and
+// the index of the tx within that block.
+func (s *Service) GetTxBlock(_ *http.Request, args *api.JSONTxID, reply *GetTxBlockReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getTxBlock"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if args.TxID == ids.Empty {
+		return errNilTxID
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	txBlock, err := s.vm.state.GetTxBlock(args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get block of tx %s: %w", args.TxID, err)
+	}
+	blk, err := s.vm.state.GetBlock(txBlock.BlockID)
+	if err != nil {
+		return fmt.Errorf("couldn't get block %s: %w", txBlock.BlockID, err)
+	}
+
+	reply.BlockID = txBlock.BlockID
+	reply.Height = avajson.Uint64(blk.Height())
+	reply.Index = avajson.Uint32(txBlock.Index)
+	return nil
+}
+
 // GetUTXOs gets all utxos for passed in addresses
 func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.GetUTXOsReply) error {
 	s.vm.ctx.Log.Debug("API called",
@@ -472,6 +591,102 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 	return nil
 }
 
+// GetAssetUTXOsArgs are arguments for passing into GetAssetUTXOs requests
+type GetAssetUTXOsArgs struct {
+	AssetID   string              `json:"assetID"`
+	Limit     avajson.Uint32      `json:"limit"`
+	StartUTXO string              `json:"startUTXO"`
+	Encoding  formatting.Encoding `json:"encoding"`
+}
+
+// GetAssetUTXOsReply defines the GetAssetUTXOs replies returned from the API
+type GetAssetUTXOsReply struct {
+	// Number of UTXOs returned
+	NumFetched avajson.Uint64 `json:"numFetched"`
+	// The UTXOs
+	UTXOs []string `json:"utxos"`
+	// The last UTXO that was returned. Passing it as [StartUTXO] fetches the
+	// next page.
+	EndUTXO string `json:"endUTXO"`
+	// Encoding specifies the encoding format the UTXOs are returned in
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetAssetUTXOs returns the unspent UTXOs of the specified asset
+func (s *Service) GetAssetUTXOs(_ *http.Request, args *GetAssetUTXOsArgs, reply *GetAssetUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAssetUTXOs"),
+		logging.UserString("assetID", args.AssetID),
+	)
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	startUTXO := ids.Empty
+	if args.StartUTXO != "" {
+		startUTXO, err = ids.FromString(args.StartUTXO)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start utxo: %w", err)
+		}
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || int(maxPageSize) < limit {
+		limit = int(maxPageSize)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	var (
+		codec   = s.vm.parser.Codec()
+		endUTXO = startUTXO
+	)
+	reply.UTXOs = make([]string, 0, limit)
+	for len(reply.UTXOs) < limit {
+		numToRead := limit - len(reply.UTXOs)
+		utxoIDs, err := s.vm.assetUTXOsIndexer.Read(assetID, endUTXO, numToRead)
+		if err != nil {
+			return fmt.Errorf("couldn't read UTXOs of asset %s: %w", assetID, err)
+		}
+
+		for _, utxoID := range utxoIDs {
+			endUTXO = utxoID
+
+			utxo, err := s.vm.state.GetUTXO(utxoID)
+			if err == database.ErrNotFound {
+				// The index may still contain UTXOs that were spent, which
+				// are skipped.
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("couldn't get UTXO %s: %w", utxoID, err)
+			}
+			b, err := codec.Marshal(txs.CodecVersion, utxo)
+			if err != nil {
+				return fmt.Errorf("problem marshalling UTXO: %w", err)
+			}
+			utxoStr, err := formatting.Encode(args.Encoding, b)
+			if err != nil {
+				return fmt.Errorf("couldn't encode UTXO %s as string: %w", utxoID, err)
+			}
+			reply.UTXOs = append(reply.UTXOs, utxoStr)
+		}
+		if len(utxoIDs) < numToRead {
+			// There are no more UTXOs in the index.
+			break
+		}
+	}
+
+	reply.EndUTXO = endUTXO.String()
+	reply.NumFetched = avajson.Uint64(len(reply.UTXOs))
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 // GetAssetDescriptionArgs are arguments for passing into GetAssetDescription requests
 type GetAssetDescriptionArgs struct {
 	AssetID string `json:"assetID"`
@@ -12,6 +12,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcutil/bech32"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -28,6 +29,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/block/executor"
 	"github.com/ava-labs/avalanchego/vms/avm/state"
@@ -1830,6 +1832,43 @@ func TestServiceGetUnknownTx(t *testing.T) {
 	require.ErrorIs(err, database.ErrNotFound)
 }
 
+func TestServiceGetTxBlock(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	env.vm.ctx.Lock.Unlock()
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	reply := GetTxBlockReply{}
+	err := env.service.GetTxBlock(nil, &api.JSONTxID{}, &reply)
+	require.ErrorIs(err, errNilTxID)
+
+	err = env.service.GetTxBlock(nil, &api.JSONTxID{TxID: ids.GenerateTestID()}, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	newTx := newAvaxBaseTxWithOutputs(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.TxFee, env.vm.parser)
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	env.vm.ctx.Lock.Lock()
+	lastAcceptedID := env.vm.state.GetLastAccepted()
+	lastAccepted, err := env.vm.state.GetBlock(lastAcceptedID)
+	env.vm.ctx.Lock.Unlock()
+	require.NoError(err)
+
+	require.NoError(env.service.GetTxBlock(nil, &api.JSONTxID{TxID: newTx.ID()}, &reply))
+	require.Equal(GetTxBlockReply{
+		BlockID: lastAcceptedID,
+		Height:  avajson.Uint64(lastAccepted.Height()),
+		Index:   0,
+	}, reply)
+}
+
 func TestServiceGetUTXOs(t *testing.T) {
 	env := setup(t, &envConfig{
 		fork: latest,
@@ -2085,6 +2124,164 @@ func TestServiceGetUTXOs(t *testing.T) {
 	}
 }
 
+func TestServiceGetAssetMints(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		vmStaticConfig: noFeesTestConfig,
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	env.vm.ctx.Lock.Unlock()
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	key := keys[0]
+	createAssetTx := newAvaxCreateAssetTxWithOutputs(t, env.vm.ctx.ChainID, env.vm.parser)
+	issueAndAccept(require, env.vm, env.issuer, createAssetTx)
+	assetID := createAssetTx.ID()
+
+	reply := &GetAssetMintsReply{}
+	require.NoError(env.service.GetAssetMints(nil, &GetAssetMintsArgs{
+		AssetID: assetID.String(),
+	}, reply))
+	require.Empty(reply.Mints)
+	require.Zero(reply.TotalMinted)
+
+	var mintTxIDs []ids.ID
+	for i := uint32(0); i < 2; i++ {
+		mintTx := buildOperationTxWithOp(env.vm.ctx.ChainID, buildSecpMintOp(createAssetTx, key, i))
+		require.NoError(mintTx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{key}}))
+		issueAndAccept(require, env.vm, env.issuer, mintTx)
+		mintTxIDs = append(mintTxIDs, mintTx.ID())
+	}
+
+	addr, err := env.vm.FormatLocalAddress(key.PublicKey().Address())
+	require.NoError(err)
+
+	var cursor avajson.Uint64
+	for _, mintTxID := range mintTxIDs {
+		reply := &GetAssetMintsReply{}
+		require.NoError(env.service.GetAssetMints(nil, &GetAssetMintsArgs{
+			AssetID:  assetID.String(),
+			Cursor:   cursor,
+			PageSize: 1,
+		}, reply))
+		require.Equal([]Mint{{
+			TxID:      mintTxID,
+			Amount:    1,
+			Threshold: 1,
+			Addresses: []string{addr},
+		}}, reply.Mints)
+		require.Equal(avajson.Uint64(len(mintTxIDs)), reply.TotalMinted)
+		require.Equal(cursor+1, reply.Cursor)
+		cursor = reply.Cursor
+	}
+
+	require.Equal(float64(len(mintTxIDs)), testutil.ToFloat64(env.vm.mintIndexer.(*mintIndex).mints))
+}
+
+func TestServiceGetAssetUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		fork: latest,
+	})
+	env.vm.ctx.Lock.Unlock()
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	avaxTx := getCreateTxFromGenesisTest(t, env.genesisBytes, "AVAX")
+	avaxAssetID := avaxTx.ID()
+
+	// getAssetUTXOs pages through the UTXOs of the AVAX asset
+	getAssetUTXOs := func(limit int) set.Set[ids.ID] {
+		var (
+			utxoIDs   set.Set[ids.ID]
+			startUTXO string
+		)
+		for {
+			reply := &GetAssetUTXOsReply{}
+			require.NoError(env.service.GetAssetUTXOs(nil, &GetAssetUTXOsArgs{
+				AssetID:   avaxAssetID.String(),
+				Limit:     avajson.Uint32(limit),
+				StartUTXO: startUTXO,
+				Encoding:  formatting.Hex,
+			}, reply))
+			require.Len(reply.UTXOs, int(reply.NumFetched))
+			require.LessOrEqual(len(reply.UTXOs), limit)
+			if reply.NumFetched == 0 {
+				return utxoIDs
+			}
+
+			for _, utxoStr := range reply.UTXOs {
+				utxoBytes, err := formatting.Decode(reply.Encoding, utxoStr)
+				require.NoError(err)
+
+				utxo := &avax.UTXO{}
+				_, err = env.vm.parser.Codec().Unmarshal(utxoBytes, utxo)
+				require.NoError(err)
+				require.Equal(avaxAssetID, utxo.AssetID())
+				utxoIDs.Add(utxo.InputID())
+			}
+			startUTXO = reply.EndUTXO
+		}
+	}
+
+	expectedUTXOIDs := set.Set[ids.ID]{}
+	for _, utxo := range avaxTx.UTXOs() {
+		expectedUTXOIDs.Add(utxo.InputID())
+	}
+	require.Equal(expectedUTXOIDs, getAssetUTXOs(1))
+
+	newTx := newAvaxBaseTxWithOutputs(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.TxFee, env.vm.parser)
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	for _, utxoID := range newTx.Unsigned.InputUTXOs() {
+		expectedUTXOIDs.Remove(utxoID.InputID())
+	}
+	for _, utxo := range newTx.UTXOs() {
+		if utxo.AssetID() == avaxAssetID {
+			expectedUTXOIDs.Add(utxo.InputID())
+		}
+	}
+	require.Equal(expectedUTXOIDs, getAssetUTXOs(2))
+
+	// UTXOs that were spent but are still in the index are skipped.
+	spentUTXOIDs := set.Set[ids.ID]{}
+	for _, utxoID := range newTx.Unsigned.InputUTXOs() {
+		spentUTXOIDs.Add(utxoID.InputID())
+	}
+	var spentUTXOs []*avax.UTXO
+	for _, utxo := range avaxTx.UTXOs() {
+		if spentUTXOIDs.Contains(utxo.InputID()) {
+			spentUTXOs = append(spentUTXOs, utxo)
+		}
+	}
+	require.NotEmpty(spentUTXOs)
+
+	env.vm.ctx.Lock.Lock()
+	require.NoError(env.vm.assetUTXOsIndexer.Accept(nil, spentUTXOs))
+	env.vm.ctx.Lock.Unlock()
+
+	require.Equal(expectedUTXOIDs, getAssetUTXOs(1))
+
+	// Unknown assets have no UTXOs
+	reply := &GetAssetUTXOsReply{}
+	require.NoError(env.service.GetAssetUTXOs(nil, &GetAssetUTXOsArgs{
+		AssetID: ids.GenerateTestID().String(),
+	}, reply))
+	require.Empty(reply.UTXOs)
+}
+
 func TestGetAssetDescription(t *testing.T) {
 	require := require.New(t)
 
@@ -418,6 +418,21 @@ func (mr *MockStateMockRecorder) GetTx(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockState)(nil).GetTx), arg0)
 }
 
+// GetTxBlock mocks base method.
+func (m *MockState) GetTxBlock(arg0 ids.ID) (TxBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxBlock", arg0)
+	ret0, _ := ret[0].(TxBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxBlock indicates an expected call of GetTxBlock.
+func (mr *MockStateMockRecorder) GetTxBlock(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxBlock", reflect.TypeOf((*MockState)(nil).GetTxBlock), arg0)
+}
+
 // GetUTXO mocks base method.
 func (m *MockState) GetUTXO(arg0 ids.ID) (*avax.UTXO, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,8 @@
 package state
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -25,6 +28,9 @@ const (
 	txCacheSize      = 8192
 	blockIDCacheSize = 8192
 	blockCacheSize   = 2048
+	txBlockCacheSize = 8192
+
+	txBlockLen = ids.IDLen + wrappers.IntLen
 )
 
 var (
@@ -32,12 +38,15 @@ var (
 	txPrefix        = []byte("tx")
 	blockIDPrefix   = []byte("blockID")
 	blockPrefix     = []byte("block")
+	txBlockPrefix   = []byte("txBlock")
 	singletonPrefix = []byte("singleton")
 
 	isInitializedKey = []byte{0x00}
 	timestampKey     = []byte{0x01}
 	lastAcceptedKey  = []byte{0x02}
 
+	errWrongTxBlockLen = errors.New("unexpected tx block length")
+
 	_ State = (*state)(nil)
 )
 
@@ -68,6 +77,11 @@ type State interface {
 	Chain
 	avax.UTXOReader
 
+	// GetTxBlock returns the ID of the accepted block that included [txID] and
+	// the index of the tx within that block. Txs accepted before the chain was
+	// linearized are not included in any block.
+	GetTxBlock(txID ids.ID) (TxBlock, error)
+
 	IsInitialized() (bool, error)
 	SetInitialized() error
 
@@ -95,6 +109,12 @@ type State interface {
 	Close() error
 }
 
+// TxBlock is the location of an accepted tx in the chain.
+type TxBlock struct {
+	BlockID ids.ID
+	Index   uint32
+}
+
 /*
  * VMDB
  * |- utxos
@@ -105,6 +125,8 @@ type State interface {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
+ * |-. txBlocks
+ * | '-- txID -> blockID + index of the tx in the block
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
@@ -130,6 +152,10 @@ type state struct {
 	blockCache  cache.Cacher[ids.ID, block.Block] // cache of blockID -> Block. If the entry is nil, it is not in the database
 	blockDB     database.Database
 
+	addedTxBlocks map[ids.ID]TxBlock             // map of txID -> TxBlock
+	txBlockCache  cache.Cacher[ids.ID, *TxBlock] // cache of txID -> TxBlock. If the entry is nil, it is not in the database
+	txBlockDB     database.Database
+
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	timestamp, persistedTimestamp       time.Time
@@ -149,6 +175,7 @@ func New(
 	txDB := prefixdb.New(txPrefix, db)
 	blockIDDB := prefixdb.New(blockIDPrefix, db)
 	blockDB := prefixdb.New(blockPrefix, db)
+	txBlockDB := prefixdb.New(txBlockPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
 
 	txCache, err := metercacher.New[ids.ID, *txs.Tx](
@@ -178,7 +205,16 @@ func New(
 		return nil, err
 	}
 
-	utxoState, err := avax.NewMeteredUTXOState(utxoDB, parser.Codec(), metrics, trackChecksums)
+	txBlockCache, err := metercacher.New[ids.ID, *TxBlock](
+		"tx_block_cache",
+		metrics,
+		&cache.LRU[ids.ID, *TxBlock]{Size: txBlockCacheSize},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	utxoState, err := avax.NewMeteredUTXOState(utxoDB, parser.Codec(), metrics, avax.DefaultUTXOCacheSize, trackChecksums)
 	if err != nil {
 		return nil, err
 	}
@@ -203,6 +239,10 @@ func New(
 		blockCache:  blockCache,
 		blockDB:     blockDB,
 
+		addedTxBlocks: make(map[ids.ID]TxBlock),
+		txBlockCache:  txBlockCache,
+		txBlockDB:     txBlockDB,
+
 		singletonDB: singletonDB,
 
 		trackChecksum: trackChecksums,
@@ -329,6 +369,44 @@ func (s *state) AddBlock(block block.Block) {
 	blkID := block.ID()
 	s.addedBlockIDs[block.Height()] = blkID
 	s.addedBlocks[blkID] = block
+	for i, tx := range block.Txs() {
+		s.addedTxBlocks[tx.ID()] = TxBlock{
+			BlockID: blkID,
+			Index:   uint32(i),
+		}
+	}
+}
+
+func (s *state) GetTxBlock(txID ids.ID) (TxBlock, error) {
+	if txBlock, exists := s.addedTxBlocks[txID]; exists {
+		return txBlock, nil
+	}
+	if txBlock, cached := s.txBlockCache.Get(txID); cached {
+		if txBlock == nil {
+			return TxBlock{}, database.ErrNotFound
+		}
+		return *txBlock, nil
+	}
+
+	txBlockBytes, err := s.txBlockDB.Get(txID[:])
+	if err == database.ErrNotFound {
+		s.txBlockCache.Put(txID, nil)
+		return TxBlock{}, database.ErrNotFound
+	}
+	if err != nil {
+		return TxBlock{}, err
+	}
+
+	if len(txBlockBytes) != txBlockLen {
+		return TxBlock{}, fmt.Errorf("%w: %d", errWrongTxBlockLen, len(txBlockBytes))
+	}
+	txBlock := &TxBlock{
+		BlockID: ids.ID(txBlockBytes[:ids.IDLen]),
+		Index:   binary.BigEndian.Uint32(txBlockBytes[ids.IDLen:]),
+	}
+
+	s.txBlockCache.Put(txID, txBlock)
+	return *txBlock, nil
 }
 
 func (s *state) InitializeChainState(stopVertexID ids.ID, genesisTimestamp time.Time) error {
@@ -413,6 +491,7 @@ func (s *state) Close() error {
 		s.txDB.Close(),
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
+		s.txBlockDB.Close(),
 		s.singletonDB.Close(),
 		s.db.Close(),
 	)
@@ -424,6 +503,7 @@ func (s *state) write() error {
 		s.writeTxs(),
 		s.writeBlockIDs(),
 		s.writeBlocks(),
+		s.writeTxBlocks(),
 		s.writeMetadata(),
 	)
 }
@@ -486,6 +566,24 @@ func (s *state) writeBlocks() error {
 	return nil
 }
 
+func (s *state) writeTxBlocks() error {
+	for txID, txBlock := range s.addedTxBlocks {
+		txID := txID
+		txBlock := txBlock
+
+		txBlockBytes := make([]byte, txBlockLen)
+		copy(txBlockBytes, txBlock.BlockID[:])
+		binary.BigEndian.PutUint32(txBlockBytes[ids.IDLen:], txBlock.Index)
+
+		delete(s.addedTxBlocks, txID)
+		s.txBlockCache.Put(txID, &txBlock)
+		if err := s.txBlockDB.Put(txID[:], txBlockBytes); err != nil {
+			return fmt.Errorf("failed to add tx block: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeMetadata() error {
 	if !s.persistedTimestamp.Equal(s.timestamp) {
 		if err := database.PutTimestamp(s.singletonDB, timestampKey, s.timestamp); err != nil {
@@ -118,6 +118,43 @@ func TestState(t *testing.T) {
 	ChainBlockTest(t, s)
 }
 
+func TestTxBlock(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	blkTxs := populatedBlk.Txs()
+	require.NotEmpty(blkTxs)
+	blkTxID := blkTxs[0].ID()
+
+	_, err = s.GetTxBlock(blkTxID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s.AddBlock(populatedBlk)
+	expectedTxBlock := TxBlock{
+		BlockID: populatedBlkID,
+		Index:   0,
+	}
+	txBlock, err := s.GetTxBlock(blkTxID)
+	require.NoError(err)
+	require.Equal(expectedTxBlock, txBlock)
+	require.NoError(s.Commit())
+
+	// The mapping must survive a restart.
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+
+	txBlock, err = s.GetTxBlock(blkTxID)
+	require.NoError(err)
+	require.Equal(expectedTxBlock, txBlock)
+
+	_, err = s.GetTxBlock(ids.GenerateTestID())
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
 func TestDiff(t *testing.T) {
 	require := require.New(t)
 
@@ -40,7 +40,10 @@ func (tx *Tx) Accept(context.Context) error {
 		return fmt.Errorf("%w: %s", errTxNotProcessing, s)
 	}
 
-	if err := tx.vm.onAccept(tx.tx); err != nil {
+	if err := database.PutBool(tx.vm.db, dagTxsKey, true); err != nil {
+		return err
+	}
+	if err := tx.vm.onAccept(tx.tx, tx.vm.state); err != nil {
 		return err
 	}
 
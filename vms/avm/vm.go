@@ -109,6 +109,13 @@ type VM struct {
 	walletService WalletService
 
 	addressTxsIndexer index.AddressTxsIndexer
+	assetUTXOsIndexer index.AssetUTXOsIndexer
+	mintIndexer       mintIndexer
+	// indexTransactions is true if accepted txs are indexed.
+	indexTransactions bool
+	// indexBackfill is true if txs accepted before indexing was enabled should
+	// be replayed into [addressTxsIndexer].
+	indexBackfill bool
 
 	txBackend *txexecutor.Backend
 
@@ -241,13 +248,11 @@ func (vm *VM) Initialize(
 
 	vm.state = state
 
-	if err := vm.initGenesis(genesisBytes); err != nil {
+	stateInitialized, err := vm.state.IsInitialized()
+	if err != nil {
 		return err
 	}
 
-	vm.walletService.vm = vm
-	vm.walletService.pendingTxs = linked.NewHashmap[ids.ID, *txs.Tx]()
-
 	// use no op impl when disabled in config
 	if avmConfig.IndexTransactions {
 		vm.ctx.Log.Warn("deprecated address transaction indexing is enabled")
@@ -255,14 +260,39 @@ func (vm *VM) Initialize(
 		if err != nil {
 			return fmt.Errorf("failed to initialize address transaction indexer: %w", err)
 		}
+		vm.assetUTXOsIndexer, err = index.NewAssetUTXOsIndexer(vm.db, stateInitialized, avmConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize asset UTXO indexer: %w", err)
+		}
+		vm.mintIndexer, err = newMintIndex(vm.db, vm.parser.Codec(), vm.registerer, stateInitialized, avmConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize mint indexer: %w", err)
+		}
+		vm.indexTransactions = true
+		vm.indexBackfill = avmConfig.IndexBackfill
 	} else {
 		vm.ctx.Log.Info("address transaction indexing is disabled")
 		vm.addressTxsIndexer, err = index.NewNoIndexer(vm.db, avmConfig.IndexAllowIncomplete)
 		if err != nil {
 			return fmt.Errorf("failed to initialize disabled indexer: %w", err)
 		}
+		vm.assetUTXOsIndexer, err = index.NewNoAssetUTXOsIndexer(vm.db)
+		if err != nil {
+			return fmt.Errorf("failed to initialize disabled asset UTXO indexer: %w", err)
+		}
+		vm.mintIndexer, err = newNoMintIndex(vm.db)
+		if err != nil {
+			return fmt.Errorf("failed to initialize disabled mint indexer: %w", err)
+		}
+	}
+
+	if err := vm.initGenesis(genesisBytes); err != nil {
+		return err
 	}
 
+	vm.walletService.vm = vm
+	vm.walletService.pendingTxs = linked.NewHashmap[ids.ID, *txs.Tx]()
+
 	vm.txBackend = &txexecutor.Backend{
 		Ctx:           ctx,
 		Config:        &vm.Config,
@@ -402,6 +432,23 @@ func (vm *VM) Linearize(ctx context.Context, stopVertexID ids.ID, toEngine chan<
 		return err
 	}
 
+	if err := vm.recordDAGTxs(); err != nil {
+		return err
+	}
+
+	// The backfill must finish before any new blocks are accepted so that the
+	// index remains ordered by acceptance.
+	if vm.indexTransactions {
+		if err := vm.recordFirstIndexedHeight(); err != nil {
+			return err
+		}
+	}
+	if vm.indexBackfill {
+		if err := vm.backfillIndex(); err != nil {
+			return fmt.Errorf("failed to backfill address transaction index: %w", err)
+		}
+	}
+
 	mempool, err := xmempool.New("mempool", vm.registerer, toEngine)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
@@ -555,7 +602,9 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 		}
 
 		if !stateInitialized {
-			vm.initState(tx)
+			if err := vm.initState(tx); err != nil {
+				return err
+			}
 		}
 		if index == 0 {
 			vm.ctx.Log.Info("fee asset is established",
@@ -573,15 +622,17 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 	return nil
 }
 
-func (vm *VM) initState(tx *txs.Tx) {
+func (vm *VM) initState(tx *txs.Tx) error {
 	txID := tx.ID()
 	vm.ctx.Log.Info("initializing genesis asset",
 		zap.Stringer("txID", txID),
 	)
 	vm.state.AddTx(tx)
-	for _, utxo := range tx.UTXOs() {
+	utxos := tx.UTXOs()
+	for _, utxo := range utxos {
 		vm.state.AddUTXO(utxo)
 	}
+	return vm.assetUTXOsIndexer.Accept(nil, utxos)
 }
 
 // LoadUser returns:
@@ -645,10 +696,31 @@ func (vm *VM) lookupAssetID(asset string) (ids.ID, error) {
 }
 
 // Invariant: onAccept is called when [tx] is being marked as accepted, but
-// before its state changes are applied.
+// before its state changes are applied. [onAcceptState] must include the
+// changes of any tx accepted alongside [tx].
 // Invariant: any error returned by onAccept should be considered fatal.
 // TODO: Remove [onAccept] once the deprecated APIs this powers are removed.
-func (vm *VM) onAccept(tx *txs.Tx) error {
+func (vm *VM) onAccept(tx *txs.Tx, onAcceptState state.ReadOnlyChain) error {
+	getUTXO := func(utxoID *avax.UTXOID) (*avax.UTXO, error) {
+		utxo, err := vm.state.GetUTXO(utxoID.InputID())
+		if err != database.ErrNotFound {
+			return utxo, err
+		}
+		// The UTXO may have been produced by a tx earlier in the same block.
+		return getProducedUTXO(onAcceptState, utxoID)
+	}
+	if err := vm.indexTx(tx, getUTXO); err != nil {
+		return err
+	}
+
+	vm.pubsub.Publish(NewPubSubFilterer(tx))
+	vm.walletService.decided(tx.ID())
+	return nil
+}
+
+// indexTx indexes the UTXOs consumed and produced by [tx]. [getUTXO] is used to
+// fetch the consumed UTXOs.
+func (vm *VM) indexTx(tx *txs.Tx, getUTXO func(*avax.UTXOID) (*avax.UTXO, error)) error {
 	// Fetch the input UTXOs
 	txID := tx.ID()
 	inputUTXOIDs := tx.Unsigned.InputUTXOs()
@@ -659,7 +731,7 @@ func (vm *VM) onAccept(tx *txs.Tx) error {
 			continue
 		}
 
-		utxo, err := vm.state.GetUTXO(utxoID.InputID())
+		utxo, err := getUTXO(utxoID)
 		if err == database.ErrNotFound {
 			vm.ctx.Log.Debug("dropping utxo from index",
 				zap.Stringer("txID", txID),
@@ -681,8 +753,8 @@ func (vm *VM) onAccept(tx *txs.Tx) error {
 	if err := vm.addressTxsIndexer.Accept(txID, inputUTXOs, outputUTXOs); err != nil {
 		return fmt.Errorf("error indexing tx: %w", err)
 	}
-
-	vm.pubsub.Publish(NewPubSubFilterer(tx))
-	vm.walletService.decided(txID)
-	return nil
+	if err := vm.assetUTXOsIndexer.Accept(inputUTXOs, outputUTXOs); err != nil {
+		return fmt.Errorf("error indexing UTXOs of tx %s: %w", txID, err)
+	}
+	return vm.mintIndexer.Accept(tx)
 }
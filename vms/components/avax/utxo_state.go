@@ -16,7 +16,10 @@ import (
 )
 
 const (
-	utxoCacheSize  = 8192
+	// DefaultUTXOCacheSize is the number of UTXOs cached by a UTXOState if no
+	// other size is provided.
+	DefaultUTXOCacheSize = 8192
+
 	indexCacheSize = 64
 )
 
@@ -92,7 +95,7 @@ func NewUTXOState(
 	s := &utxoState{
 		codec: codec,
 
-		utxoCache: &cache.LRU[ids.ID, *UTXO]{Size: utxoCacheSize},
+		utxoCache: &cache.LRU[ids.ID, *UTXO]{Size: DefaultUTXOCacheSize},
 		utxoDB:    prefixdb.New(utxoPrefix, db),
 
 		indexDB:    prefixdb.New(indexPrefix, db),
@@ -107,6 +110,7 @@ func NewMeteredUTXOState(
 	db database.Database,
 	codec codec.Manager,
 	metrics prometheus.Registerer,
+	utxoCacheSize int,
 	trackChecksum bool,
 ) (UTXOState, error) {
 	utxoCache, err := metercacher.New[ids.ID, *UTXO](
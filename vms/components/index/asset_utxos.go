@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+)
+
+const assetCacheSize = 64
+
+var (
+	ErrIncompleteAssetUTXOsIndex = errors.New("asset UTXO index is incomplete. Allow incomplete indices or re-sync from genesis with indexing enabled")
+
+	assetUTXOsPrefix = []byte("assetUTXOs")
+
+	_ AssetUTXOsIndexer = (*assetUTXOsIndexer)(nil)
+	_ AssetUTXOsIndexer = (*noAssetUTXOsIndexer)(nil)
+)
+
+// AssetUTXOsIndexer maintains the IDs of the unspent UTXOs of each asset.
+type AssetUTXOsIndexer interface {
+	// Accept is called when a transaction is accepted.
+	// [inputUTXOs] are the UTXOs the transaction consumes.
+	// [outputUTXOs] are the UTXOs the transaction creates.
+	// If the error is non-nil, do not persist the transaction to disk as
+	// accepted in the VM
+	Accept(inputUTXOs []*avax.UTXO, outputUTXOs []*avax.UTXO) error
+
+	// Read returns the IDs of unspent UTXOs of [assetID] in increasing order,
+	// starting after [start]. [start] doesn't need to be in the index, so a
+	// UTXO that was spent after it was returned can still be used to page.
+	// Returns at most [limit] IDs.
+	Read(assetID ids.ID, start ids.ID, limit int) ([]ids.ID, error)
+}
+
+type assetUTXOsIndexer struct {
+	db         database.Database
+	indexCache cache.Cacher[ids.ID, database.Database]
+
+	complete        bool
+	allowIncomplete bool
+}
+
+// NewAssetUTXOsIndexer returns a new AssetUTXOsIndexer.
+//
+// If the index has never been initialized, it is only considered complete if
+// [chainInitialized] is false, as UTXOs produced before the index existed
+// aren't included in it. Reads from an incomplete index fail unless
+// [allowIncomplete] is true.
+func NewAssetUTXOsIndexer(
+	db database.Database,
+	chainInitialized bool,
+	allowIncomplete bool,
+) (AssetUTXOsIndexer, error) {
+	db = prefixdb.New(assetUTXOsPrefix, db)
+	complete, err := InitCompleteness(db, chainInitialized)
+	if err != nil {
+		return nil, err
+	}
+	return &assetUTXOsIndexer{
+		db:              db,
+		indexCache:      &cache.LRU[ids.ID, database.Database]{Size: assetCacheSize},
+		complete:        complete,
+		allowIncomplete: allowIncomplete,
+	}, nil
+}
+
+// Accept removes [inputUTXOs] from, and adds [outputUTXOs] to, the index.
+// The database structure is:
+// [assetID]
+// |  unspent UTXO IDs
+func (i *assetUTXOsIndexer) Accept(inputUTXOs []*avax.UTXO, outputUTXOs []*avax.UTXO) error {
+	for _, utxo := range inputUTXOs {
+		utxoID := utxo.InputID()
+		if err := i.getIndexDB(utxo.AssetID()).Delete(utxoID[:]); err != nil {
+			return err
+		}
+	}
+	for _, utxo := range outputUTXOs {
+		utxoID := utxo.InputID()
+		if err := i.getIndexDB(utxo.AssetID()).Put(utxoID[:], nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read returns the IDs of the unspent UTXOs of [assetID].
+// See AssetUTXOsIndexer
+func (i *assetUTXOsIndexer) Read(assetID ids.ID, start ids.ID, limit int) ([]ids.ID, error) {
+	if !i.complete && !i.allowIncomplete {
+		return nil, ErrIncompleteAssetUTXOsIndex
+	}
+
+	iter := i.getIndexDB(assetID).NewIteratorWithStart(start[:])
+	defer iter.Release()
+
+	utxoIDs := []ids.ID(nil)
+	for len(utxoIDs) < limit && iter.Next() {
+		utxoID, err := ids.ToID(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if utxoID == start {
+			continue
+		}
+		utxoIDs = append(utxoIDs, utxoID)
+	}
+	return utxoIDs, iter.Error()
+}
+
+// InitCompleteness returns true if the index stored in [db] contains the
+// effects of every accepted transaction.
+//
+// If the index has never been initialized, it is only considered complete if
+// [chainInitialized] is false, as transactions accepted before the index
+// existed aren't included in it.
+func InitCompleteness(db database.KeyValueReaderWriter, chainInitialized bool) (bool, error) {
+	complete, err := database.GetBool(db, idxCompleteKey)
+	if err == database.ErrNotFound {
+		complete = !chainInitialized
+		err = database.PutBool(db, idxCompleteKey, complete)
+	}
+	return complete, err
+}
+
+// MarkIncomplete records that the index stored in [db] is missing the effects
+// of some accepted transactions.
+func MarkIncomplete(db database.KeyValueWriter) error {
+	return database.PutBool(db, idxCompleteKey, false)
+}
+
+func (i *assetUTXOsIndexer) getIndexDB(assetID ids.ID) database.Database {
+	if indexDB, exists := i.indexCache.Get(assetID); exists {
+		return indexDB
+	}
+
+	indexDB := prefixdb.NewNested(assetID[:], i.db)
+	i.indexCache.Put(assetID, indexDB)
+	return indexDB
+}
+
+type noAssetUTXOsIndexer struct{}
+
+// NewNoAssetUTXOsIndexer returns an AssetUTXOsIndexer that doesn't index
+// anything. Running with it marks any existing index as incomplete.
+func NewNoAssetUTXOsIndexer(db database.Database) (AssetUTXOsIndexer, error) {
+	db = prefixdb.New(assetUTXOsPrefix, db)
+	return &noAssetUTXOsIndexer{}, MarkIncomplete(db)
+}
+
+func (*noAssetUTXOsIndexer) Accept([]*avax.UTXO, []*avax.UTXO) error {
+	return nil
+}
+
+func (*noAssetUTXOsIndexer) Read(ids.ID, ids.ID, int) ([]ids.ID, error) {
+	return nil, ErrIncompleteAssetUTXOsIndex
+}
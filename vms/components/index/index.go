@@ -25,8 +25,10 @@ var (
 	ErrIndexingRequiredFromGenesis = errors.New("running would create incomplete index. Allow incomplete indices or re-sync from genesis with indexing enabled")
 	ErrCausesIncompleteIndex       = errors.New("running would create incomplete index. Allow incomplete indices or enable indexing")
 
-	idxKey         = []byte("idx")
-	idxCompleteKey = []byte("complete")
+	idxKey                = []byte("idx")
+	idxCompleteKey        = []byte("complete")
+	backfillHeightKey     = []byte("backfillHeight")
+	firstIndexedHeightKey = []byte("firstIndexedHeight")
 
 	_ AddressTxsIndexer = (*indexer)(nil)
 	_ AddressTxsIndexer = (*noIndexer)(nil)
@@ -82,7 +84,11 @@ func NewIndexer(
 	}
 	// initialize the metrics
 	if err := i.metrics.initialize(metricsNamespace, metricsRegisterer); err != nil {
-		return nil, err
+		// Failing to export the indexer's metrics shouldn't prevent the VM
+		// from indexing txs.
+		log.Warn("failed to register indexer metrics",
+			zap.Error(err),
+		)
 	}
 	return i, nil
 }
@@ -246,6 +252,59 @@ func checkIndexStatus(db database.KeyValueReaderWriter, enableIndexing, allowInc
 	return nil
 }
 
+// IsComplete returns true if the index contains every accepted transaction.
+func IsComplete(db database.KeyValueReader) (bool, error) {
+	complete, err := database.GetBool(db, idxCompleteKey)
+	if err == database.ErrNotFound {
+		return false, nil
+	}
+	return complete, err
+}
+
+// MarkComplete records that the index contains every accepted transaction.
+// This should only be called once a backfill has replayed all transactions that
+// were accepted before indexing was enabled.
+func MarkComplete(db database.KeyValueWriterDeleter) error {
+	if err := database.PutBool(db, idxCompleteKey, true); err != nil {
+		return err
+	}
+	return db.Delete(backfillHeightKey)
+}
+
+// GetBackfillHeight returns the height of the next block whose transactions
+// should be backfilled into the index. If no backfill has been started, 0 is
+// returned.
+func GetBackfillHeight(db database.KeyValueReader) (uint64, error) {
+	height, err := database.GetUInt64(db, backfillHeightKey)
+	if err == database.ErrNotFound {
+		return 0, nil
+	}
+	return height, err
+}
+
+// PutBackfillHeight records the height of the next block whose transactions
+// should be backfilled into the index.
+func PutBackfillHeight(db database.KeyValueWriter, height uint64) error {
+	return database.PutUInt64(db, backfillHeightKey, height)
+}
+
+// GetFirstIndexedHeight returns the height of the first block whose
+// transactions were indexed as they were accepted. If no such height has been
+// recorded, 0 is returned.
+func GetFirstIndexedHeight(db database.KeyValueReader) (uint64, error) {
+	height, err := database.GetUInt64(db, firstIndexedHeightKey)
+	if err == database.ErrNotFound {
+		return 0, nil
+	}
+	return height, err
+}
+
+// PutFirstIndexedHeight records the height of the first block whose
+// transactions are indexed as they are accepted.
+func PutFirstIndexedHeight(db database.KeyValueWriter, height uint64) error {
+	return database.PutUInt64(db, firstIndexedHeightKey, height)
+}
+
 type noIndexer struct{}
 
 func NewNoIndexer(db database.Database, allowIncomplete bool) (AddressTxsIndexer, error) {
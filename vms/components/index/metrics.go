@@ -3,17 +3,26 @@
 
 package index
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/utils/metric"
+)
 
 type metrics struct {
 	numTxsIndexed prometheus.Counter
 }
 
+// initialize creates the metrics and registers them with [registerer]. An
+// already registered counter is shared. If registration fails for any other
+// reason, the metrics are still usable but aren't exported.
 func (m *metrics) initialize(namespace string, registerer prometheus.Registerer) error {
-	m.numTxsIndexed = prometheus.NewCounter(prometheus.CounterOpts{
+	numTxsIndexed := prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: namespace,
 		Name:      "txs_indexed",
 		Help:      "Number of transactions indexed",
 	})
-	return registerer.Register(m.numTxsIndexed)
+	var err error
+	m.numTxsIndexed, err = metric.RegisterOrExistingCounter(registerer, numTxsIndexed)
+	return err
 }
@@ -0,0 +1,30 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api"
+)
+
+// AdminService defines the privileged API calls of the P-chain. It is only
+// registered if the admin API is enabled.
+type AdminService struct {
+	vm *VM
+}
+
+// RebuildValidators clears the node's validator sets and repopulates them from
+// the current stakers. This is an escape hatch for when the validator sets have
+// diverged from the P-chain state.
+func (s *AdminService) RebuildValidators(r *http.Request, _ *struct{}, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "rebuildValidators"),
+	)
+
+	return s.vm.RebuildValidators(r.Context())
+}
@@ -17,8 +17,8 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
-	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -28,10 +28,6 @@ import (
 	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
 )
 
-// targetBlockSize is maximum number of transaction bytes to place into a
-// StandardBlock
-const targetBlockSize = 128 * units.KiB
-
 var (
 	_ Builder = (*builder)(nil)
 
@@ -75,6 +71,11 @@ type builder struct {
 
 	txExecutorBackend *txexecutor.Backend
 	blkManager        blockexecutor.Manager
+	metrics           metrics.Metrics
+
+	// maxBlockSize is the maximum number of transaction bytes to place into a
+	// block.
+	maxBlockSize int
 
 	// resetTimer is used to signal that the block builder timer should update
 	// when it will trigger building of a block.
@@ -87,11 +88,15 @@ func New(
 	mempool mempool.Mempool,
 	txExecutorBackend *txexecutor.Backend,
 	blkManager blockexecutor.Manager,
+	metrics metrics.Metrics,
+	maxBlockSize int,
 ) Builder {
 	return &builder{
 		Mempool:           mempool,
 		txExecutorBackend: txExecutorBackend,
 		blkManager:        blkManager,
+		metrics:           metrics,
+		maxBlockSize:      maxBlockSize,
 		resetTimer:        make(chan struct{}, 1),
 		closed:            make(chan struct{}),
 	}
@@ -171,7 +176,7 @@ func (b *builder) durationToSleep() (time.Duration, error) {
 		return 0, fmt.Errorf("%w: %s", errMissingPreferredState, preferredID)
 	}
 
-	nextStakerChangeTime, err := txexecutor.GetNextStakerChangeTime(preferredState)
+	nextStakerChangeTime, err := txexecutor.GetNextStakerChangeTime(b.txExecutorBackend.Config, preferredState)
 	if err != nil {
 		return 0, fmt.Errorf("%w of %s: %w", errCalculatingNextStakerTime, preferredID, err)
 	}
@@ -216,7 +221,7 @@ func (b *builder) BuildBlock(context.Context) (snowman.Block, error) {
 		return nil, fmt.Errorf("%w: %s", state.ErrMissingParentState, preferredID)
 	}
 
-	timestamp, timeWasCapped, err := txexecutor.NextBlockTime(preferredState, b.txExecutorBackend.Clk)
+	timestamp, timeWasCapped, err := txexecutor.NextBlockTime(b.txExecutorBackend.Config, preferredState, b.txExecutorBackend.Clk)
 	if err != nil {
 		return nil, fmt.Errorf("could not calculate next staker change time: %w", err)
 	}
@@ -249,6 +254,7 @@ func (b *builder) PackBlockTxs(targetBlockSize int) ([]*txs.Tx, error) {
 		b.Mempool,
 		b.txExecutorBackend,
 		b.blkManager,
+		b.metrics,
 		b.txExecutorBackend.Clk.Time(),
 		targetBlockSize,
 	)
@@ -269,8 +275,9 @@ func buildBlock(
 		builder.Mempool,
 		builder.txExecutorBackend,
 		builder.blkManager,
+		builder.metrics,
 		timestamp,
-		targetBlockSize,
+		builder.maxBlockSize,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pack block txs: %w", err)
@@ -319,6 +326,7 @@ func packBlockTxs(
 	mempool mempool.Mempool,
 	backend *txexecutor.Backend,
 	manager blockexecutor.Manager,
+	metrics metrics.Metrics,
 	timestamp time.Time,
 	remainingSize int,
 ) ([]*txs.Tx, error) {
@@ -343,6 +351,13 @@ func packBlockTxs(
 		}
 		txSize := len(tx.Bytes())
 		if txSize > remainingSize {
+			// The remaining txs are left in the mempool to be included in a
+			// future block.
+			backend.Ctx.Log.Debug("trimmed block txs to fit the max block size",
+				zap.Int("numTxs", len(blockTxs)),
+				zap.Int("remainingSize", remainingSize),
+			)
+			metrics.IncBlocksTrimmed()
 			break
 		}
 		mempool.Remove(tx)
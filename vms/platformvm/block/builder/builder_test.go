@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -20,6 +21,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -72,6 +75,75 @@ func TestBuildBlockBasic(t *testing.T) {
 	require.NoError(env.mempool.GetDropReason(txID))
 }
 
+func TestBuildBlockTrimsTxsToMaxBlockSize(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t, latestFork)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	registry := prometheus.NewRegistry()
+	blockMetrics, err := metrics.New("", registry)
+	require.NoError(err)
+	env.Builder.(*builder).metrics = blockMetrics
+
+	// Create two valid transactions that don't conflict with each other
+	txIDs := make([]ids.ID, 2)
+	for i, key := range preFundedKeys[3:5] {
+		tx, err := env.txBuilder.NewCreateSubnetTx(
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{key.PublicKey().Address()},
+			},
+			[]*secp256k1.PrivateKey{key},
+		)
+		require.NoError(err)
+		txIDs[i] = tx.ID()
+
+		env.ctx.Lock.Unlock()
+		require.NoError(env.network.IssueTxFromRPC(tx))
+		env.ctx.Lock.Lock()
+
+		// Only allow a single tx to fit into the block
+		env.Builder.(*builder).maxBlockSize = len(tx.Bytes())
+	}
+
+	// [BuildBlock] should only include the first transaction
+	blkIntf, err := env.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+
+	require.IsType(&blockexecutor.Block{}, blkIntf)
+	blk := blkIntf.(*blockexecutor.Block)
+	require.Len(blk.Txs(), 1)
+	require.Equal(txIDs[0], blk.Txs()[0].ID())
+
+	// The trimmed transaction should remain in the mempool
+	_, ok := env.mempool.Get(txIDs[0])
+	require.False(ok)
+	_, ok = env.mempool.Get(txIDs[1])
+	require.True(ok)
+
+	// Only the block that was stopped by the size limit is counted as trimmed
+	require.Equal(float64(1), blocksTrimmed(t, registry))
+
+	env.Builder.(*builder).maxBlockSize = config.DefaultExecutionConfig.MaxBlockSize
+	_, err = env.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.Equal(float64(1), blocksTrimmed(t, registry))
+}
+
+func blocksTrimmed(t *testing.T, gatherer prometheus.Gatherer) float64 {
+	metricFamilies, err := gatherer.Gather()
+	require.NoError(t, err)
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() == "blocks_trimmed" {
+			return metricFamily.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+	require.FailNow(t, "blocks_trimmed metric not found")
+	return 0
+}
+
 func TestBuildBlockDoesNotBuildWithEmptyMempool(t *testing.T) {
 	require := require.New(t)
 
@@ -180,7 +180,7 @@ func newEnvironment(t *testing.T, f fork) *environment { //nolint:unparam
 	metrics, err := metrics.New("", registerer)
 	require.NoError(err)
 
-	res.mempool, err = mempool.New("mempool", registerer, nil)
+	res.mempool, err = mempool.New("mempool", registerer, nil, txs.DefaultMaxInputs)
 	require.NoError(err)
 
 	res.blkManager = blockexecutor.NewManager(
@@ -189,6 +189,7 @@ func newEnvironment(t *testing.T, f fork) *environment { //nolint:unparam
 		res.state,
 		&res.backend,
 		pvalidators.TestManager,
+		nil,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&res.ctx.Lock, res.blkManager)
@@ -210,6 +211,8 @@ func newEnvironment(t *testing.T, f fork) *environment { //nolint:unparam
 		res.mempool,
 		&res.backend,
 		res.blkManager,
+		metrics,
+		config.DefaultExecutionConfig.MaxBlockSize,
 	)
 	res.Builder.StartBlockTimer()
 
@@ -319,6 +322,8 @@ func defaultConfig(t *testing.T, f fork) *config.Config {
 		MinDelegatorStake: 1 * units.MilliAvax,
 		MinStakeDuration:  defaultMinStakingDuration,
 		MaxStakeDuration:  defaultMaxStakingDuration,
+		MaxChainFxs:       txs.DefaultMaxFxIDs,
+		MaxTxInputs:       txs.DefaultMaxInputs,
 		RewardConfig: reward.Config{
 			MaxConsumptionRate: .12 * reward.PercentDenominator,
 			MinConsumptionRate: .10 * reward.PercentDenominator,
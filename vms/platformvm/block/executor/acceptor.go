@@ -30,6 +30,9 @@ type acceptor struct {
 	metrics      metrics.Metrics
 	validators   validators.Manager
 	bootstrapped *utils.Atomic[bool]
+	// listener, if non-nil, is notified of blocks once they have been
+	// committed to the database.
+	listener AcceptListener
 }
 
 func (a *acceptor) BanffAbortBlock(b *block.BanffAbortBlock) error {
@@ -74,7 +77,7 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		return err
 	}
 
-	blkState, ok := a.blkIDToState[blkID]
+	blkState, ok := a.getBlockState(blkID)
 	if !ok {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
@@ -85,7 +88,7 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 	}
 
 	defer a.state.Abort()
-	batch, err := a.state.CommitBatch()
+	batch, err := a.commitBatch()
 	if err != nil {
 		return fmt.Errorf(
 			"failed to commit VM's database for block %s: %w",
@@ -104,6 +107,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		)
 	}
 
+	a.notify(b)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", "apricot atomic"),
@@ -118,7 +123,7 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 
 func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 	parentID := b.Parent()
-	parentState, ok := a.blkIDToState[parentID]
+	parentState, ok := a.getBlockState(parentID)
 	if !ok {
 		return fmt.Errorf("%w: %s", state.ErrMissingParentState, parentID)
 	}
@@ -146,7 +151,7 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		}
 	}
 
-	blkState, ok := a.blkIDToState[blkID]
+	blkState, ok := a.getBlockState(blkID)
 	if !ok {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
@@ -155,7 +160,7 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 	}
 
 	defer a.state.Abort()
-	batch, err := a.state.CommitBatch()
+	batch, err := a.commitBatch()
 	if err != nil {
 		return fmt.Errorf(
 			"failed to commit VM's database for block %s: %w",
@@ -173,6 +178,9 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	a.notify(parentState.statelessBlock)
+	a.notify(b)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -203,7 +211,7 @@ func (a *acceptor) proposalBlock(b block.Block, blockType string) {
 	//   The snowman.Engine requires that the last committed block is a decision block
 
 	blkID := b.ID()
-	a.backend.lastAccepted = blkID
+	a.markProposalAccepted(blkID)
 
 	a.ctx.Log.Trace(
 		"accepted block",
@@ -223,7 +231,7 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		return err
 	}
 
-	blkState, ok := a.blkIDToState[blkID]
+	blkState, ok := a.getBlockState(blkID)
 	if !ok {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
@@ -234,7 +242,7 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 	}
 
 	defer a.state.Abort()
-	batch, err := a.state.CommitBatch()
+	batch, err := a.commitBatch()
 	if err != nil {
 		return fmt.Errorf(
 			"failed to commit VM's database for block %s: %w",
@@ -252,6 +260,8 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	a.notify(b)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -271,10 +281,13 @@ func (a *acceptor) commonAccept(b block.Block) error {
 		return fmt.Errorf("failed to accept block %s: %w", blkID, err)
 	}
 
-	a.backend.lastAccepted = blkID
-	a.state.SetLastAccepted(blkID)
-	a.state.SetHeight(b.Height())
-	a.state.AddStatelessBlock(b)
+	a.markAccepted(blkID, b)
 	a.validators.OnAcceptedBlockID(blkID)
 	return nil
 }
+
+func (a *acceptor) notify(b block.Block) {
+	if a.listener != nil {
+		a.listener.Accepted(b)
+	}
+}
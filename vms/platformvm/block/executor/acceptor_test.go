@@ -52,6 +52,7 @@ func TestAcceptorVisitProposalBlock(t *testing.T) {
 
 	acceptor := &acceptor{
 		backend: &backend{
+			metrics: metrics.Noop,
 			ctx: &snow.Context{
 				Log: logging.NoLog{},
 			},
@@ -87,6 +88,7 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 	parentID := ids.GenerateTestID()
 	acceptor := &acceptor{
 		backend: &backend{
+			metrics:      metrics.Noop,
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
@@ -165,8 +167,10 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 
 	parentID := ids.GenerateTestID()
 	clk := &mockable.Clock{}
+	listener := &testAcceptListener{}
 	acceptor := &acceptor{
 		backend: &backend{
+			metrics:      metrics.Noop,
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
@@ -177,6 +181,7 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 		},
 		metrics:    metrics.Noop,
 		validators: validators.TestManager,
+		listener:   listener,
 	}
 
 	blk, err := block.NewBanffStandardBlock(
@@ -203,6 +208,7 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 
 	err = acceptor.BanffStandardBlock(blk)
 	require.ErrorIs(err, errMissingBlockState)
+	require.Empty(listener.accepted)
 
 	// Set [blk]'s state in the map as though it had been verified.
 	onAcceptState := state.NewMockDiff(ctrl)
@@ -244,6 +250,7 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	require.NoError(acceptor.BanffStandardBlock(blk))
 	require.True(calledOnAcceptFunc)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
+	require.Equal([]block.Block{blk}, listener.accepted)
 }
 
 func TestAcceptorVisitCommitBlock(t *testing.T) {
@@ -256,6 +263,7 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 	parentID := ids.GenerateTestID()
 	acceptor := &acceptor{
 		backend: &backend{
+			metrics:      metrics.Noop,
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
@@ -366,6 +374,7 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 	parentID := ids.GenerateTestID()
 	acceptor := &acceptor{
 		backend: &backend{
+			metrics:      metrics.Noop,
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
@@ -465,3 +474,11 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 	require.True(calledOnAcceptFunc)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
+
+type testAcceptListener struct {
+	accepted []block.Block
+}
+
+func (l *testAcceptListener) Accepted(blk block.Block) {
+	l.accepted = append(l.accepted, blk)
+}
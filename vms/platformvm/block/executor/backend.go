@@ -5,12 +5,16 @@ package executor
 
 import (
 	"errors"
+	"sync"
 	"time"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/choices"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
 )
@@ -20,6 +24,12 @@ var errConflictingParentTxs = errors.New("block contains a transaction that conf
 // Shared fields used by visitors.
 type backend struct {
 	mempool.Mempool
+
+	// lock protects [lastAccepted], [blkIDToState], and the blocks added to
+	// [state] while they are accepted. This allows blocks to be looked up
+	// without holding the context lock. The contents of a blockState are still
+	// protected by the context lock.
+	lock sync.RWMutex
 	// lastAccepted is the ID of the last block that had Accept() called on it.
 	lastAccepted ids.ID
 
@@ -30,13 +40,21 @@ type backend struct {
 	// All other blocks are removed when they are accepted/rejected.
 	// Note that Genesis block is a commit block so no need to update
 	// blkIDToState with it upon backend creation (Genesis is already accepted)
+	//
+	// Decided blocks are evicted as soon as no processing block can reference
+	// them, so the map only grows with the number of processing blocks. Blocks
+	// that were evicted are read from [state].
 	blkIDToState map[ids.ID]*blockState
 	state        state.State
 
-	ctx *snow.Context
+	ctx     *snow.Context
+	metrics metrics.Metrics
 }
 
 func (b *backend) GetState(blkID ids.ID) (state.Chain, bool) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
 	// If the block is in the map, it is either processing or a proposal block
 	// that was accepted without an accepted child.
 	if state, ok := b.blkIDToState[blkID]; ok {
@@ -52,7 +70,7 @@ func (b *backend) GetState(blkID ids.ID) (state.Chain, bool) {
 }
 
 func (b *backend) getOnAbortState(blkID ids.ID) (state.Diff, bool) {
-	state, ok := b.blkIDToState[blkID]
+	state, ok := b.getBlockState(blkID)
 	if !ok || state.onAbortState == nil {
 		return nil, false
 	}
@@ -60,7 +78,7 @@ func (b *backend) getOnAbortState(blkID ids.ID) (state.Diff, bool) {
 }
 
 func (b *backend) getOnCommitState(blkID ids.ID) (state.Diff, bool) {
-	state, ok := b.blkIDToState[blkID]
+	state, ok := b.getBlockState(blkID)
 	if !ok || state.onCommitState == nil {
 		return nil, false
 	}
@@ -68,6 +86,9 @@ func (b *backend) getOnCommitState(blkID ids.ID) (state.Diff, bool) {
 }
 
 func (b *backend) GetBlock(blkID ids.ID) (block.Block, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
 	// See if the block is in memory.
 	if blk, ok := b.blkIDToState[blkID]; ok {
 		return blk.statelessBlock, nil
@@ -78,18 +99,101 @@ func (b *backend) GetBlock(blkID ids.ID) (block.Block, error) {
 }
 
 func (b *backend) LastAccepted() ids.ID {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
 	return b.lastAccepted
 }
 
+// getStatus returns the status of [blkID]. Processing is returned for blocks
+// that are neither accepted nor known to be processing, as the caller has the
+// block's bytes.
+func (b *backend) getStatus(blkID ids.ID) (choices.Status, error) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	// If this block is an accepted Proposal block with no accepted children, it
+	// will be in [blkIDToState], but we should return accepted, not processing,
+	// so we do this check.
+	if b.lastAccepted == blkID {
+		return choices.Accepted, nil
+	}
+	// Check if the block is in memory. If so, it's processing.
+	if _, ok := b.blkIDToState[blkID]; ok {
+		return choices.Processing, nil
+	}
+	// Block isn't in memory. Check in the database.
+	switch _, err := b.state.GetStatelessBlock(blkID); err {
+	case nil:
+		return choices.Accepted, nil
+	case database.ErrNotFound:
+		// choices.Unknown means we don't have the bytes of the block.
+		// In this case, we do, so we return choices.Processing.
+		return choices.Processing, nil
+	default:
+		return choices.Processing, err
+	}
+}
+
+// markAccepted records [blk], whose ID is [blkID], as the last accepted block
+// and adds it to [state], so that it can still be looked up once it is freed.
+func (b *backend) markAccepted(blkID ids.ID, blk block.Block) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.lastAccepted = blkID
+	b.state.SetLastAccepted(blkID)
+	b.state.SetHeight(blk.Height())
+	b.state.AddStatelessBlock(blk)
+}
+
+// markProposalAccepted records the proposal block [blkID] as the last
+// accepted block. It is added to [state] once its child is accepted.
+func (b *backend) markProposalAccepted(blkID ids.ID) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.lastAccepted = blkID
+}
+
+// commitBatch writes the accepted blocks from memory into the batch that is
+// returned, while no block is being looked up.
+func (b *backend) commitBatch() (database.Batch, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.state.CommitBatch()
+}
+
+func (b *backend) getBlockState(blkID ids.ID) (*blockState, bool) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	blkState, ok := b.blkIDToState[blkID]
+	return blkState, ok
+}
+
+func (b *backend) putBlockState(blkID ids.ID, blkState *blockState) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.blkIDToState[blkID] = blkState
+	b.metrics.SetBlocksInMemory(len(b.blkIDToState))
+}
+
 func (b *backend) free(blkID ids.ID) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
 	delete(b.blkIDToState, blkID)
+	b.metrics.SetBlocksInMemory(len(b.blkIDToState))
 }
 
 func (b *backend) getTimestamp(blkID ids.ID) time.Time {
 	// Check if the block is processing.
 	// If the block is processing, then we are guaranteed to have populated its
 	// timestamp in its state.
-	if blkState, ok := b.blkIDToState[blkID]; ok {
+	if blkState, ok := b.getBlockState(blkID); ok {
 		return blkState.timestamp
 	}
 
@@ -109,7 +213,7 @@ func (b *backend) verifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
 
 	// Check for conflicts in ancestors.
 	for {
-		state, ok := b.blkIDToState[blkID]
+		state, ok := b.getBlockState(blkID)
 		if !ok {
 			// The parent state isn't pinned in memory.
 			// This means the parent must be accepted already.
@@ -4,16 +4,24 @@
 package executor
 
 import (
+	"context"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 func TestGetState(t *testing.T) {
@@ -26,7 +34,8 @@ func TestGetState(t *testing.T) {
 		blkID1        = ids.GenerateTestID()
 		blkID2        = ids.GenerateTestID()
 		b             = &backend{
-			state: mockState,
+			metrics: metrics.Noop,
+			state:   mockState,
 			blkIDToState: map[ids.ID]*blockState{
 				blkID1: {
 					onAcceptState: onAcceptState,
@@ -66,6 +75,145 @@ func TestGetState(t *testing.T) {
 	}
 }
 
+// Run with the race detector to verify that blocks can be looked up without
+// holding the context lock while other blocks are parsed, verified, and
+// accepted.
+func TestBackendConcurrentBlockStateAccess(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		numBlocks  = 10
+		numReaders = 4
+	)
+
+	env := newEnvironment(t, nil, banff)
+
+	var (
+		wg   sync.WaitGroup
+		done = make(chan struct{})
+	)
+	wg.Add(numReaders)
+	for i := 0; i < numReaders; i++ {
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				blk, err := env.blkManager.GetBlock(env.blkManager.LastAccepted())
+				if err != nil {
+					continue
+				}
+				_ = blk.Status()
+				if parent, err := env.blkManager.GetBlock(blk.Parent()); err == nil {
+					_ = parent.Status()
+				}
+			}
+		}()
+	}
+
+	// Build, parse, verify, and accept the blocks while holding the context
+	// lock, as the engine does.
+	processBlock := func() (ids.ID, error) {
+		env.ctx.Lock.Lock()
+		defer env.ctx.Lock.Unlock()
+
+		tx, err := env.txBuilder.NewCreateSubnetTx(
+			&secp256k1fx.OutputOwners{},
+			[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		)
+		if err != nil {
+			return ids.Empty, err
+		}
+
+		parentID := env.blkManager.LastAccepted()
+		parent, err := env.blkManager.GetStatelessBlock(parentID)
+		if err != nil {
+			return ids.Empty, err
+		}
+		builtBlk, err := block.NewBanffStandardBlock(
+			env.state.GetTimestamp(),
+			parentID,
+			parent.Height()+1,
+			[]*txs.Tx{tx},
+		)
+		if err != nil {
+			return ids.Empty, err
+		}
+
+		statelessBlk, err := block.Parse(block.Codec, builtBlk.Bytes())
+		if err != nil {
+			return ids.Empty, err
+		}
+		blk := env.blkManager.NewBlock(statelessBlk)
+		if err := blk.Verify(context.Background()); err != nil {
+			return ids.Empty, err
+		}
+		return blk.ID(), blk.Accept(context.Background())
+	}
+
+	var (
+		blkIDs     = make([]ids.ID, 0, numBlocks)
+		processErr error
+	)
+	for i := 0; i < numBlocks && processErr == nil; i++ {
+		var blkID ids.ID
+		blkID, processErr = processBlock()
+		blkIDs = append(blkIDs, blkID)
+	}
+	close(done)
+	wg.Wait()
+	require.NoError(processErr)
+
+	require.Equal(blkIDs[numBlocks-1], env.blkManager.LastAccepted())
+	for _, blkID := range blkIDs {
+		blk, err := env.blkManager.GetBlock(blkID)
+		require.NoError(err)
+		require.Equal(choices.Accepted, blk.Status())
+	}
+}
+
+func TestBackendBlocksInMemoryMetric(t *testing.T) {
+	require := require.New(t)
+
+	registerer := prometheus.NewRegistry()
+	m, err := metrics.New("", registerer)
+	require.NoError(err)
+
+	b := &backend{
+		metrics:      m,
+		blkIDToState: map[ids.ID]*blockState{},
+	}
+	blocksInMemory := func() float64 {
+		metricFamilies, err := registerer.Gather()
+		require.NoError(err)
+		for _, metricFamily := range metricFamilies {
+			if metricFamily.GetName() == "blocks_in_memory" {
+				return metricFamily.GetMetric()[0].GetGauge().GetValue()
+			}
+		}
+		require.FailNow("missing blocks_in_memory metric")
+		return 0
+	}
+
+	blkID1 := ids.GenerateTestID()
+	blkID2 := ids.GenerateTestID()
+	b.putBlockState(blkID1, &blockState{})
+	b.putBlockState(blkID2, &blockState{})
+	require.Equal(float64(2), blocksInMemory())
+
+	// Decided blocks are evicted.
+	b.free(blkID1)
+	require.Equal(float64(1), blocksInMemory())
+	b.free(blkID2)
+	require.Zero(blocksInMemory())
+	require.Empty(b.blkIDToState)
+}
+
 func TestBackendGetBlock(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -75,7 +223,8 @@ func TestBackendGetBlock(t *testing.T) {
 		statelessBlk = block.NewMockBlock(ctrl)
 		state        = state.NewMockState(ctrl)
 		b            = &backend{
-			state: state,
+			metrics: metrics.Noop,
+			state:   state,
 			blkIDToState: map[ids.ID]*blockState{
 				blkID1: {
 					statelessBlock: statelessBlk,
@@ -122,6 +271,7 @@ func TestGetTimestamp(t *testing.T) {
 			name: "block is in map",
 			backendF: func(*gomock.Controller) *backend {
 				return &backend{
+					metrics: metrics.Noop,
 					blkIDToState: map[ids.ID]*blockState{
 						blkID: {
 							timestamp: time.Unix(1337, 0),
@@ -137,7 +287,8 @@ func TestGetTimestamp(t *testing.T) {
 				state := state.NewMockState(ctrl)
 				state.EXPECT().GetTimestamp().Return(time.Unix(1337, 0))
 				return &backend{
-					state: state,
+					metrics: metrics.Noop,
+					state:   state,
 				}
 			},
 			expectedTimestamp: time.Unix(1337, 0),
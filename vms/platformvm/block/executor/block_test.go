@@ -19,6 +19,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -43,6 +44,7 @@ func TestStatus(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
+						metrics:      metrics.Noop,
 						lastAccepted: blkID,
 					},
 				}
@@ -63,6 +65,7 @@ func TestStatus(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
+						metrics: metrics.Noop,
 						blkIDToState: map[ids.ID]*blockState{
 							blkID: {},
 						},
@@ -87,7 +90,8 @@ func TestStatus(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
+						metrics: metrics.Noop,
+						state:   state,
 					},
 				}
 				return &Block{
@@ -109,7 +113,8 @@ func TestStatus(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
+						metrics: metrics.Noop,
+						state:   state,
 					},
 				}
 				return &Block{
@@ -148,8 +153,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -175,8 +181,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -211,8 +218,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -249,8 +257,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -290,8 +299,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -341,8 +351,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -397,8 +408,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -453,8 +465,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -515,8 +528,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -577,8 +591,9 @@ func TestBlockOptions(t *testing.T) {
 
 				manager := &manager{
 					backend: &backend{
-						state: state,
-						ctx:   snowtest.Context(t, snowtest.PChainID),
+						metrics: metrics.Noop,
+						state:   state,
+						ctx:     snowtest.Context(t, snowtest.PChainID),
 					},
 					txExecutorBackend: &executor.Backend{
 						Config: &config.Config{
@@ -196,7 +196,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f fork) *environment
 	metrics := metrics.Noop
 
 	var err error
-	res.mempool, err = mempool.New("mempool", registerer, nil)
+	res.mempool, err = mempool.New("mempool", registerer, nil, txs.DefaultMaxInputs)
 	if err != nil {
 		panic(fmt.Errorf("failed to create mempool: %w", err))
 	}
@@ -208,6 +208,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f fork) *environment
 			res.state,
 			res.backend,
 			pvalidators.TestManager,
+			nil,
 		)
 		addSubnet(res)
 	} else {
@@ -217,6 +218,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f fork) *environment
 			res.mockedState,
 			res.backend,
 			pvalidators.TestManager,
+			nil,
 		)
 		// we do not add any subnet to state, since we can mock
 		// whatever we need
@@ -341,6 +343,8 @@ func defaultConfig(t *testing.T, f fork) *config.Config {
 		MinDelegatorStake: 1 * units.MilliAvax,
 		MinStakeDuration:  defaultMinStakingDuration,
 		MaxStakeDuration:  defaultMaxStakingDuration,
+		MaxChainFxs:       txs.DefaultMaxFxIDs,
+		MaxTxInputs:       txs.DefaultMaxInputs,
 		RewardConfig: reward.Config{
 			MaxConsumptionRate: .12 * reward.PercentDenominator,
 			MinConsumptionRate: .10 * reward.PercentDenominator,
@@ -46,12 +46,19 @@ type Manager interface {
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
 }
 
+// AcceptListener is notified of accepted blocks. Proposal blocks are reported
+// once their option is accepted, immediately before the option itself.
+type AcceptListener interface {
+	Accepted(block.Block)
+}
+
 func NewManager(
 	mempool mempool.Mempool,
 	metrics metrics.Metrics,
 	s state.State,
 	txExecutorBackend *executor.Backend,
 	validatorManager validators.Manager,
+	listener AcceptListener,
 ) Manager {
 	lastAccepted := s.GetLastAccepted()
 	backend := &backend{
@@ -59,6 +66,7 @@ func NewManager(
 		lastAccepted: lastAccepted,
 		state:        s,
 		ctx:          txExecutorBackend.Ctx,
+		metrics:      metrics,
 		blkIDToState: map[ids.ID]*blockState{},
 	}
 
@@ -73,6 +81,7 @@ func NewManager(
 			metrics:      metrics,
 			validators:   validatorManager,
 			bootstrapped: txExecutorBackend.Bootstrapped,
+			listener:     listener,
 		},
 		rejector: &rejector{
 			backend:         backend,
@@ -132,7 +141,7 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		return err
 	}
 
-	nextBlkTime, _, err := executor.NextBlockTime(stateDiff, m.txExecutorBackend.Clk)
+	nextBlkTime, _, err := executor.NextBlockTime(m.txExecutorBackend.Config, stateDiff, m.txExecutorBackend.Clk)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 )
 
@@ -24,6 +25,7 @@ func TestGetBlock(t *testing.T) {
 	state := state.NewMockState(ctrl)
 	manager := &manager{
 		backend: &backend{
+			metrics:      metrics.Noop,
 			state:        state,
 			blkIDToState: map[ids.ID]*blockState{},
 		},
@@ -65,6 +67,7 @@ func TestManagerLastAccepted(t *testing.T) {
 	lastAcceptedID := ids.GenerateTestID()
 	manager := &manager{
 		backend: &backend{
+			metrics:      metrics.Noop,
 			lastAccepted: lastAcceptedID,
 		},
 	}
@@ -209,7 +209,7 @@ func (v *verifier) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 	v.Mempool.Remove(b.Tx)
 
 	blkID := b.ID()
-	v.blkIDToState[blkID] = &blockState{
+	v.putBlockState(blkID, &blockState{
 		statelessBlock: b,
 
 		onAcceptState: atomicExecutor.OnAccept,
@@ -217,7 +217,7 @@ func (v *verifier) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		inputs:         atomicExecutor.Inputs,
 		timestamp:      atomicExecutor.OnAccept.GetTimestamp(),
 		atomicRequests: atomicExecutor.AtomicRequests,
-	}
+	})
 	return nil
 }
 
@@ -266,7 +266,7 @@ func (v *verifier) banffNonOptionBlock(b block.BanffBlock) error {
 		)
 	}
 
-	nextStakerChangeTime, err := executor.GetNextStakerChangeTime(parentState)
+	nextStakerChangeTime, err := executor.GetNextStakerChangeTime(v.txExecutorBackend.Config, parentState)
 	if err != nil {
 		return fmt.Errorf("could not verify block timestamp: %w", err)
 	}
@@ -325,11 +325,11 @@ func (v *verifier) abortBlock(b block.Block) error {
 	}
 
 	blkID := b.ID()
-	v.blkIDToState[blkID] = &blockState{
+	v.putBlockState(blkID, &blockState{
 		statelessBlock: b,
 		onAcceptState:  onAbortState,
 		timestamp:      onAbortState.GetTimestamp(),
-	}
+	})
 	return nil
 }
 
@@ -342,11 +342,11 @@ func (v *verifier) commitBlock(b block.Block) error {
 	}
 
 	blkID := b.ID()
-	v.blkIDToState[blkID] = &blockState{
+	v.putBlockState(blkID, &blockState{
 		statelessBlock: b,
 		onAcceptState:  onCommitState,
 		timestamp:      onCommitState.GetTimestamp(),
-	}
+	})
 	return nil
 }
 
@@ -379,7 +379,7 @@ func (v *verifier) proposalBlock(
 	v.Mempool.Remove(b.Tx)
 
 	blkID := b.ID()
-	v.blkIDToState[blkID] = &blockState{
+	v.putBlockState(blkID, &blockState{
 		proposalBlockState: proposalBlockState{
 			onDecisionState: onDecisionState,
 			onCommitState:   onCommitState,
@@ -396,7 +396,7 @@ func (v *verifier) proposalBlock(
 		// always be the same as the Banff Proposal Block.
 		timestamp:      onAbortState.GetTimestamp(),
 		atomicRequests: atomicRequests,
-	}
+	})
 	return nil
 }
 
@@ -413,7 +413,7 @@ func (v *verifier) standardBlock(
 	v.Mempool.Remove(b.Transactions...)
 
 	blkID := b.ID()
-	v.blkIDToState[blkID] = &blockState{
+	v.putBlockState(blkID, &blockState{
 		statelessBlock: b,
 
 		onAcceptState: onAcceptState,
@@ -422,7 +422,7 @@ func (v *verifier) standardBlock(
 		timestamp:      onAcceptState.GetTimestamp(),
 		inputs:         inputs,
 		atomicRequests: atomicRequests,
-	}
+	})
 	return nil
 }
 
@@ -21,6 +21,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -43,6 +44,7 @@ func TestVerifierVisitProposalBlock(t *testing.T) {
 	parentOnAcceptState.EXPECT().GetTimestamp().Return(timestamp).Times(2)
 
 	backend := &backend{
+		metrics:      metrics.Noop,
 		lastAccepted: parentID,
 		blkIDToState: map[ids.ID]*blockState{
 			parentID: {
@@ -130,6 +132,7 @@ func TestVerifierVisitAtomicBlock(t *testing.T) {
 	parentState := state.NewMockDiff(ctrl)
 
 	backend := &backend{
+		metrics: metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{
 			parentID: {
 				statelessBlock: parentStatelessBlk,
@@ -219,6 +222,7 @@ func TestVerifierVisitStandardBlock(t *testing.T) {
 	parentState := state.NewMockDiff(ctrl)
 
 	backend := &backend{
+		metrics: metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{
 			parentID: {
 				statelessBlock: parentStatelessBlk,
@@ -322,6 +326,7 @@ func TestVerifierVisitCommitBlock(t *testing.T) {
 	parentOnAbortState := state.NewMockDiff(ctrl)
 
 	backend := &backend{
+		metrics: metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{
 			parentID: {
 				statelessBlock: parentStatelessBlk,
@@ -395,6 +400,7 @@ func TestVerifierVisitAbortBlock(t *testing.T) {
 	parentOnAbortState := state.NewMockDiff(ctrl)
 
 	backend := &backend{
+		metrics: metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{
 			parentID: {
 				statelessBlock: parentStatelessBlk,
@@ -465,6 +471,7 @@ func TestVerifyUnverifiedParent(t *testing.T) {
 	parentID := ids.GenerateTestID()
 
 	backend := &backend{
+		metrics:      metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{},
 		Mempool:      mempool,
 		state:        s,
@@ -539,6 +546,7 @@ func TestBanffAbortBlockTimestampChecks(t *testing.T) {
 			parentHeight := uint64(1)
 
 			backend := &backend{
+				metrics:      metrics.Noop,
 				blkIDToState: make(map[ids.ID]*blockState),
 				Mempool:      mempool,
 				state:        s,
@@ -637,6 +645,7 @@ func TestBanffCommitBlockTimestampChecks(t *testing.T) {
 			parentHeight := uint64(1)
 
 			backend := &backend{
+				metrics:      metrics.Noop,
 				blkIDToState: make(map[ids.ID]*blockState),
 				Mempool:      mempool,
 				state:        s,
@@ -708,6 +717,7 @@ func TestVerifierVisitStandardBlockWithDuplicateInputs(t *testing.T) {
 	atomicInputs := set.Of(ids.GenerateTestID())
 
 	backend := &backend{
+		metrics: metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{
 			grandParentID: {
 				statelessBlock: grandParentStatelessBlk,
@@ -801,6 +811,7 @@ func TestVerifierVisitApricotStandardBlockWithProposalBlockParent(t *testing.T)
 	parentOnAbortState := state.NewMockDiff(ctrl)
 
 	backend := &backend{
+		metrics: metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{
 			parentID: {
 				statelessBlock: parentStatelessBlk,
@@ -860,6 +871,7 @@ func TestVerifierVisitBanffStandardBlockWithProposalBlockParent(t *testing.T) {
 	parentOnAbortState := state.NewMockDiff(ctrl)
 
 	backend := &backend{
+		metrics: metrics.Noop,
 		blkIDToState: map[ids.ID]*blockState{
 			parentID: {
 				statelessBlock: parentStatelessBlk,
@@ -924,6 +936,7 @@ func TestVerifierVisitApricotCommitBlockUnexpectedParentState(t *testing.T) {
 			Clk: &mockable.Clock{},
 		},
 		backend: &backend{
+			metrics: metrics.Noop,
 			blkIDToState: map[ids.ID]*blockState{
 				parentID: {
 					statelessBlock: parentStatelessBlk,
@@ -969,6 +982,7 @@ func TestVerifierVisitBanffCommitBlockUnexpectedParentState(t *testing.T) {
 			Clk: &mockable.Clock{},
 		},
 		backend: &backend{
+			metrics: metrics.Noop,
 			blkIDToState: map[ids.ID]*blockState{
 				parentID: {
 					statelessBlock: parentStatelessBlk,
@@ -1015,6 +1029,7 @@ func TestVerifierVisitApricotAbortBlockUnexpectedParentState(t *testing.T) {
 			Clk: &mockable.Clock{},
 		},
 		backend: &backend{
+			metrics: metrics.Noop,
 			blkIDToState: map[ids.ID]*blockState{
 				parentID: {
 					statelessBlock: parentStatelessBlk,
@@ -1060,6 +1075,7 @@ func TestVerifierVisitBanffAbortBlockUnexpectedParentState(t *testing.T) {
 			Clk: &mockable.Clock{},
 		},
 		backend: &backend{
+			metrics: metrics.Noop,
 			blkIDToState: map[ids.ID]*blockState{
 				parentID: {
 					statelessBlock: parentStatelessBlk,
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package blockstream
+
+import (
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// connection is a representation of a subscriber's websocket connection.
+type connection struct {
+	s *Server
+
+	// The websocket connection.
+	conn *websocket.Conn
+
+	// Buffered channel of outbound blocks.
+	send chan *BlockSummary
+
+	// closed is closed by the server once the connection is removed. Any
+	// blocks still queued in [send] are dropped.
+	closed chan struct{}
+
+	// lagging is set, while holding the server lock, before [closed] is closed
+	// if the subscriber was dropped for falling behind.
+	lagging bool
+}
+
+// readPump discards incoming messages so that control frames are processed
+// and a closed connection is noticed.
+func (c *connection) readPump() {
+	defer func() {
+		c.s.removeConnection(c)
+
+		// close is called by both the writePump and the readPump so one of them
+		// will always error
+		_ = c.conn.Close()
+		c.s.pumps.Done()
+	}()
+
+	c.conn.SetReadLimit(maxMessageSize)
+	// SetReadDeadline returns an error if the connection is corrupted
+	if err := c.conn.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
+		return
+	}
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		if _, _, err := c.conn.NextReader(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				c.s.log.Debug("unexpected close in websockets",
+					zap.Error(err),
+				)
+			}
+			return
+		}
+	}
+}
+
+// writePump pumps accepted blocks to the websocket connection.
+//
+// A goroutine running writePump is started for each connection. The
+// application ensures that there is at most one writer to a connection by
+// executing all writes from this goroutine.
+func (c *connection) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.s.removeConnection(c)
+
+		// close is called by both the writePump and the readPump so one of them
+		// will always error
+		_ = c.conn.Close()
+		c.s.pumps.Done()
+	}()
+	for {
+		// Prioritize closing the connection over draining queued blocks.
+		select {
+		case <-c.closed:
+			c.writeClose()
+			return
+		default:
+		}
+
+		select {
+		case <-c.closed:
+			c.writeClose()
+			return
+		case summary := <-c.send:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				c.s.log.Debug("closing the connection",
+					zap.String("reason", "failed to set the write deadline"),
+					zap.Error(err),
+				)
+				return
+			}
+			if err := c.conn.WriteJSON(summary); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				c.s.log.Debug("closing the connection",
+					zap.String("reason", "failed to set the write deadline"),
+					zap.Error(err),
+				)
+				return
+			}
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// writeClose attempts to close the connection gracefully, telling the
+// subscriber why if it was dropped for falling behind.
+func (c *connection) writeClose() {
+	if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return
+	}
+
+	c.s.lock.Lock()
+	lagging := c.lagging
+	c.s.lock.Unlock()
+
+	msg := []byte{}
+	if lagging {
+		msg = websocket.FormatCloseMessage(websocket.CloseTryAgainLater, CloseReasonTooSlow)
+	}
+	_ = c.conn.WriteMessage(websocket.CloseMessage, msg)
+}
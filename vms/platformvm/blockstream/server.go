@@ -0,0 +1,234 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package blockstream
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+)
+
+const (
+	// Size of the ws read buffer
+	readBufferSize = units.KiB
+
+	// Size of the ws write buffer
+	writeBufferSize = units.KiB
+
+	// Time allowed to write a message to the peer.
+	writeWait = 10 * time.Second
+
+	// Time allowed to read the next pong message from the peer.
+	pongWait = 60 * time.Second
+
+	// Send pings to peer with this period. Must be less than pongWait.
+	pingPeriod = (pongWait * 9) / 10
+
+	// Maximum message size allowed from peer. Subscribers aren't expected to
+	// send anything other than control frames.
+	maxMessageSize = units.KiB
+
+	// MaxSubscribers is the maximum number of subscribers that may be
+	// connected at the same time.
+	MaxSubscribers = 64
+
+	// MaxPendingBlocks is the number of accepted blocks that may be queued for
+	// a subscriber before it is disconnected for falling behind.
+	MaxPendingBlocks = 256
+
+	// CloseReasonTooSlow is sent alongside [websocket.CloseTryAgainLater] when
+	// a subscriber is disconnected for falling behind.
+	CloseReasonTooSlow = "subscriber fell too far behind"
+)
+
+var (
+	errClosed         = errors.New("block stream closed")
+	errTooManyClients = errors.New("too many block subscribers")
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  readBufferSize,
+	WriteBufferSize: writeBufferSize,
+	CheckOrigin: func(*http.Request) bool {
+		return true
+	},
+}
+
+// BlockSummary is the message pushed to subscribers for every accepted block.
+type BlockSummary struct {
+	ID       ids.ID      `json:"id"`
+	ParentID ids.ID      `json:"parentID"`
+	Height   json.Uint64 `json:"height"`
+	// Timestamp is only populated for post-Banff blocks.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+	TxIDs     []ids.ID   `json:"txIDs"`
+}
+
+func newBlockSummary(blk block.Block) *BlockSummary {
+	txs := blk.Txs()
+	summary := &BlockSummary{
+		ID:       blk.ID(),
+		ParentID: blk.Parent(),
+		Height:   json.Uint64(blk.Height()),
+		TxIDs:    make([]ids.ID, len(txs)),
+	}
+	if banffBlk, ok := blk.(block.BanffBlock); ok {
+		timestamp := banffBlk.Timestamp()
+		summary.Timestamp = &timestamp
+	}
+	for i, tx := range txs {
+		summary.TxIDs[i] = tx.ID()
+	}
+	return summary
+}
+
+// Server streams accepted blocks to websocket subscribers.
+//
+// Every handler returned by the VM is served under the chain's own route, so a
+// subscriber selects the chain it is interested in by connecting to that
+// chain's endpoint.
+type Server struct {
+	log logging.Logger
+
+	lock   sync.Mutex
+	closed bool
+	conns  set.Set[*connection]
+	// tracks the running read and write pumps
+	pumps sync.WaitGroup
+}
+
+func New(log logging.Logger) *Server {
+	return &Server{
+		log: log,
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	err := s.canSubscribeLocked()
+	s.lock.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	wsConn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.log.Debug("failed to upgrade",
+			zap.Error(err),
+		)
+		return
+	}
+
+	conn := &connection{
+		s:      s,
+		conn:   wsConn,
+		send:   make(chan *BlockSummary, MaxPendingBlocks),
+		closed: make(chan struct{}),
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// The server may have been closed, or filled up, while upgrading.
+	if err := s.canSubscribeLocked(); err != nil {
+		_ = wsConn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()),
+			time.Now().Add(writeWait),
+		)
+		_ = wsConn.Close()
+		return
+	}
+
+	s.conns.Add(conn)
+	s.pumps.Add(2)
+	go conn.writePump()
+	go conn.readPump()
+}
+
+func (s *Server) canSubscribeLocked() error {
+	switch {
+	case s.closed:
+		return errClosed
+	case s.conns.Len() >= MaxSubscribers:
+		return fmt.Errorf("%w: %d", errTooManyClients, MaxSubscribers)
+	default:
+		return nil
+	}
+}
+
+// Accepted pushes [blk] to all subscribers. Subscribers whose queue is full are
+// disconnected rather than blocking block acceptance.
+func (s *Server) Accepted(blk block.Block) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.conns.Len() == 0 {
+		return
+	}
+
+	summary := newBlockSummary(blk)
+	for conn := range s.conns {
+		select {
+		case conn.send <- summary:
+		default:
+			s.log.Debug("dropping block subscriber",
+				zap.String("reason", "too many pending blocks"),
+			)
+			conn.lagging = true
+			s.removeConnectionLocked(conn)
+		}
+	}
+}
+
+// Close disconnects all subscribers, rejects new ones, and waits for the
+// connections to be closed.
+func (s *Server) Close() {
+	s.lock.Lock()
+	s.closed = true
+	for conn := range s.conns {
+		s.removeConnectionLocked(conn)
+	}
+	s.lock.Unlock()
+
+	s.pumps.Wait()
+}
+
+// Len returns the number of active subscribers.
+func (s *Server) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.conns.Len()
+}
+
+func (s *Server) removeConnection(conn *connection) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.removeConnectionLocked(conn)
+}
+
+// removeConnectionLocked signals the write pump of [conn] to close the
+// websocket. It is safe to call multiple times.
+func (s *Server) removeConnectionLocked(conn *connection) {
+	if !s.conns.Contains(conn) {
+		return
+	}
+	s.conns.Remove(conn)
+	close(conn.closed)
+}
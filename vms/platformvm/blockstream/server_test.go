@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package blockstream
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+)
+
+func TestServerStreamsAcceptedBlocks(t *testing.T) {
+	require := require.New(t)
+
+	s := New(logging.NoLog{})
+	httpServer := httptest.NewServer(s)
+	defer httpServer.Close()
+
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(err)
+	defer conn.Close()
+
+	require.Eventually(func() bool {
+		return s.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	timestamp := time.Unix(1607133600, 0).UTC()
+	blk, err := block.NewBanffStandardBlock(timestamp, ids.GenerateTestID(), 1, nil)
+	require.NoError(err)
+	s.Accepted(blk)
+
+	summary := &BlockSummary{}
+	require.NoError(conn.ReadJSON(summary))
+	require.Equal(&BlockSummary{
+		ID:        blk.ID(),
+		ParentID:  blk.Parent(),
+		Height:    json.Uint64(1),
+		Timestamp: &timestamp,
+		TxIDs:     []ids.ID{},
+	}, summary)
+}
+
+func TestServerDropsLaggingSubscriber(t *testing.T) {
+	require := require.New(t)
+
+	s := New(logging.NoLog{})
+	conn := &connection{
+		s:      s,
+		send:   make(chan *BlockSummary, MaxPendingBlocks),
+		closed: make(chan struct{}),
+	}
+	s.conns.Add(conn)
+
+	blk, err := block.NewBanffStandardBlock(time.Time{}, ids.GenerateTestID(), 1, nil)
+	require.NoError(err)
+	for i := 0; i < MaxPendingBlocks; i++ {
+		s.Accepted(blk)
+	}
+	require.Equal(1, s.Len())
+	require.False(conn.lagging)
+
+	s.Accepted(blk)
+	require.Zero(s.Len())
+	require.True(conn.lagging)
+	require.NotPanics(func() {
+		s.removeConnection(conn)
+	})
+
+	select {
+	case <-conn.closed:
+	default:
+		require.FailNow("connection wasn't closed")
+	}
+}
+
+func TestServerLimitsSubscribers(t *testing.T) {
+	require := require.New(t)
+
+	s := New(logging.NoLog{})
+	for i := 0; i < MaxSubscribers; i++ {
+		s.conns.Add(&connection{
+			s:      s,
+			send:   make(chan *BlockSummary, MaxPendingBlocks),
+			closed: make(chan struct{}),
+		})
+	}
+
+	httpServer := httptest.NewServer(s)
+	defer httpServer.Close()
+
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.ErrorIs(err, websocket.ErrBadHandshake)
+	require.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+	require.NoError(resp.Body.Close())
+}
+
+func TestServerClose(t *testing.T) {
+	require := require.New(t)
+
+	s := New(logging.NoLog{})
+	httpServer := httptest.NewServer(s)
+	defer httpServer.Close()
+
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(err)
+	defer conn.Close()
+
+	require.Eventually(func() bool {
+		return s.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Close returns once both pumps of the subscriber have exited.
+	s.Close()
+	require.Zero(s.Len())
+
+	_, _, err = conn.ReadMessage()
+	var closeErr *websocket.CloseError
+	require.ErrorAs(err, &closeErr)
+
+	// New subscribers are rejected once the server is closed.
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.ErrorIs(err, websocket.ErrBadHandshake)
+	require.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+	require.NoError(resp.Body.Close())
+}
@@ -57,6 +57,17 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetSpendableUTXOs returns the byte representation of the UTXOs from
+	// [sourceChain] that [addrs] are able to spend at the current chain time
+	GetSpendableUTXOs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		sourceChain string,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([][]byte, ids.ShortID, ids.ID, error)
 	// GetSubnet returns information about the specified subnet
 	GetSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (GetSubnetClientResponse, error)
 	// GetSubnets returns information about the specified subnets
@@ -119,6 +130,9 @@ type Client interface {
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
+	// GetValidatorSetHash returns a hash of the current validator set of a
+	// provided subnet, along with the chain time and height it corresponds to.
+	GetValidatorSetHash(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*GetValidatorSetHashReply, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
 	// subnet at the specified height.
 	GetValidatorsAt(
@@ -131,6 +145,9 @@ type Client interface {
 	GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
+	// GetBlocks returns the blocks with the given ids, along with the ids of
+	// any blocks that weren't found.
+	GetBlocks(ctx context.Context, blockIDs []ids.ID, options ...rpc.Option) ([][]byte, []ids.ID, error)
 }
 
 // Client implementation for interacting with the P Chain endpoint
@@ -196,17 +213,45 @@ func (c *client) GetAtomicUTXOs(
 	startAddress ids.ShortID,
 	startUTXOID ids.ID,
 	options ...rpc.Option,
+) ([][]byte, ids.ShortID, ids.ID, error) {
+	return c.getUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID, false, options...)
+}
+
+func (c *client) GetSpendableUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	sourceChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) ([][]byte, ids.ShortID, ids.ID, error) {
+	return c.getUTXOs(ctx, addrs, sourceChain, limit, startAddress, startUTXOID, true, options...)
+}
+
+func (c *client) getUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	sourceChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	spendableOnly bool,
+	options ...rpc.Option,
 ) ([][]byte, ids.ShortID, ids.ID, error) {
 	res := &api.GetUTXOsReply{}
-	err := c.requester.SendRequest(ctx, "platform.getUTXOs", &api.GetUTXOsArgs{
-		Addresses:   ids.ShortIDsToStrings(addrs),
-		SourceChain: sourceChain,
-		Limit:       json.Uint32(limit),
-		StartIndex: api.Index{
-			Address: startAddress.String(),
-			UTXO:    startUTXOID.String(),
+	err := c.requester.SendRequest(ctx, "platform.getUTXOs", &GetUTXOsArgs{
+		GetUTXOsArgs: api.GetUTXOsArgs{
+			Addresses:   ids.ShortIDsToStrings(addrs),
+			SourceChain: sourceChain,
+			Limit:       json.Uint32(limit),
+			StartIndex: api.Index{
+				Address: startAddress.String(),
+				UTXO:    startUTXOID.String(),
+			},
+			Encoding: formatting.Hex,
 		},
-		Encoding: formatting.Hex,
+		SpendableOnly: spendableOnly,
 	}, res, options...)
 	if err != nil {
 		return nil, ids.ShortID{}, ids.Empty, err
@@ -509,6 +554,14 @@ func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.
 	return res.Timestamp, err
 }
 
+func (c *client) GetValidatorSetHash(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*GetValidatorSetHashReply, error) {
+	res := &GetValidatorSetHashReply{}
+	err := c.requester.SendRequest(ctx, "platform.getValidatorSetHash", &GetValidatorSetHashArgs{
+		SubnetID: subnetID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetValidatorsAt(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -545,3 +598,27 @@ func (c *client) GetBlockByHeight(ctx context.Context, height uint64, options ..
 	}
 	return formatting.Decode(res.Encoding, res.Block)
 }
+
+func (c *client) GetBlocks(ctx context.Context, blockIDs []ids.ID, options ...rpc.Option) ([][]byte, []ids.ID, error) {
+	res := &struct {
+		Blocks          []string            `json:"blocks"`
+		Encoding        formatting.Encoding `json:"encoding"`
+		MissingBlockIDs []ids.ID            `json:"missingBlockIDs"`
+	}{}
+	err := c.requester.SendRequest(ctx, "platform.getBlocks", &GetBlocksArgs{
+		BlockIDs: blockIDs,
+		Encoding: formatting.HexNC,
+	}, res, options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blocks := make([][]byte, len(res.Blocks))
+	for i, blockStr := range res.Blocks {
+		blocks[i], err = formatting.Decode(res.Encoding, blockStr)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return blocks, res.MissingBlockIDs, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
@@ -23,6 +24,10 @@ type Config struct {
 	// The node's chain manager
 	Chains chains.Manager
 
+	// Fx verifies the credentials of transfers and subnet authorizations. If
+	// nil, secp256k1fx is used.
+	Fx fx.Fx
+
 	// Node's validator set maps subnetID -> validators of the subnet
 	//
 	// Invariant: The primary network's validator set should have been added to
@@ -67,6 +72,19 @@ type Config struct {
 	// Maximum amount of time to allow a staker to stake
 	MaxStakeDuration time.Duration
 
+	// Amount of time after a permissioned subnet validator's end time before
+	// it is removed from the current validator set, once the E upgrade is
+	// activated
+	SubnetValidatorRemovalGracePeriod time.Duration
+
+	// Maximum number of feature extensions a new chain may run, once the E
+	// upgrade is activated
+	MaxChainFxs int
+
+	// Maximum number of inputs a tx may consume, including imported inputs, to
+	// be added to the mempool
+	MaxTxInputs int
+
 	// Config for the minting function
 	RewardConfig reward.Config
 
@@ -81,6 +99,19 @@ type Config struct {
 	// on recently created subnets (without this, users need to wait for
 	// [recentlyAcceptedWindowTTL] to pass for activation to occur).
 	UseCurrentHeight bool
+
+	// If true, the P-chain admin API is served. The admin API allows the node
+	// operator to recover from local state inconsistencies.
+	AdminAPIEnabled bool
+}
+
+// RemovalGracePeriod returns the amount of time after a permissioned subnet
+// validator's end time before it is removed, given the chain's [timestamp].
+func (c *Config) RemovalGracePeriod(timestamp time.Time) time.Duration {
+	if !c.UpgradeConfig.IsEActivated(timestamp) {
+		return 0
+	}
+	return c.SubnetValidatorRemovalGracePeriod
 }
 
 // Create the blockchain described in [tx], but only if this node is a member of
@@ -5,10 +5,20 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/platformvm/network"
+
+	txmempool "github.com/ava-labs/avalanchego/vms/txs/mempool"
+)
+
+var (
+	errMaxBlockSizeTooSmall = errors.New("max-block-size is smaller than the max tx size")
+	errMaxBlockSizeTooLarge = errors.New("max-block-size exceeds the max container size")
 )
 
 var DefaultExecutionConfig = ExecutionConfig{
@@ -21,8 +31,11 @@ var DefaultExecutionConfig = ExecutionConfig{
 	ChainDBCacheSize:             2048,
 	BlockIDCacheSize:             8192,
 	FxOwnerCacheSize:             4 * units.MiB,
+	UTXOCacheSize:                8192,
 	ChecksumsEnabled:             false,
 	MempoolPruneFrequency:        30 * time.Minute,
+	BlockStalenessWindow:         10 * time.Minute,
+	MaxBlockSize:                 128 * units.KiB,
 }
 
 // ExecutionConfig provides execution parameters of PlatformVM
@@ -36,8 +49,11 @@ type ExecutionConfig struct {
 	ChainDBCacheSize             int            `json:"chain-db-cache-size"`
 	BlockIDCacheSize             int            `json:"block-id-cache-size"`
 	FxOwnerCacheSize             int            `json:"fx-owner-cache-size"`
+	UTXOCacheSize                int            `json:"utxo-cache-size"`
 	ChecksumsEnabled             bool           `json:"checksums-enabled"`
 	MempoolPruneFrequency        time.Duration  `json:"mempool-prune-frequency"`
+	BlockStalenessWindow         time.Duration  `json:"block-staleness-window"`
+	MaxBlockSize                 int            `json:"max-block-size"`
 }
 
 // GetExecutionConfig returns an ExecutionConfig
@@ -51,5 +67,19 @@ func GetExecutionConfig(b []byte) (*ExecutionConfig, error) {
 		return &ec, nil
 	}
 
-	return &ec, json.Unmarshal(b, &ec)
+	if err := json.Unmarshal(b, &ec); err != nil {
+		return nil, err
+	}
+	return &ec, ec.verify()
+}
+
+func (c *ExecutionConfig) verify() error {
+	switch {
+	case c.MaxBlockSize < txmempool.MaxTxSize:
+		return fmt.Errorf("%w: %d < %d", errMaxBlockSizeTooSmall, c.MaxBlockSize, txmempool.MaxTxSize)
+	case c.MaxBlockSize > constants.MaxContainersLen:
+		return fmt.Errorf("%w: %d > %d", errMaxBlockSizeTooLarge, c.MaxBlockSize, constants.MaxContainersLen)
+	default:
+		return nil
+	}
 }
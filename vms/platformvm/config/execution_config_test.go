@@ -69,8 +69,11 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			"chain-db-cache-size": 7,
 			"block-id-cache-size": 8,
 			"fx-owner-cache-size": 9,
+			"utxo-cache-size": 10,
 			"checksums-enabled": true,
-			"mempool-prune-frequency": 60000000000
+			"mempool-prune-frequency": 60000000000,
+			"block-staleness-window": 120000000000,
+			"max-block-size": 100000
 		}`)
 		ec, err := GetExecutionConfig(b)
 		require.NoError(err)
@@ -102,8 +105,11 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			ChainDBCacheSize:             7,
 			BlockIDCacheSize:             8,
 			FxOwnerCacheSize:             9,
+			UTXOCacheSize:                10,
 			ChecksumsEnabled:             true,
 			MempoolPruneFrequency:        time.Minute,
+			BlockStalenessWindow:         2 * time.Minute,
+			MaxBlockSize:                 100000,
 		}
 		require.Equal(expected, ec)
 	})
@@ -160,9 +166,24 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			ChainDBCacheSize:             7,
 			BlockIDCacheSize:             8,
 			FxOwnerCacheSize:             9,
+			UTXOCacheSize:                DefaultExecutionConfig.UTXOCacheSize,
 			ChecksumsEnabled:             true,
 			MempoolPruneFrequency:        30 * time.Minute,
+			BlockStalenessWindow:         DefaultExecutionConfig.BlockStalenessWindow,
+			MaxBlockSize:                 DefaultExecutionConfig.MaxBlockSize,
 		}
 		require.Equal(expected, ec)
 	})
+
+	t.Run("max block size smaller than max tx size", func(t *testing.T) {
+		b := []byte(`{"max-block-size":1024}`)
+		_, err := GetExecutionConfig(b)
+		require.ErrorIs(t, err, errMaxBlockSizeTooSmall)
+	})
+
+	t.Run("max block size larger than max container size", func(t *testing.T) {
+		b := []byte(`{"max-block-size":4194304}`)
+		_, err := GetExecutionConfig(b)
+		require.ErrorIs(t, err, errMaxBlockSizeTooLarge)
+	})
 }
@@ -5,6 +5,7 @@ package platformvm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,11 +13,14 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 )
 
+var errBlockProductionStalled = errors.New("block production stalled")
+
 func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 	localPrimaryValidator, err := vm.state.GetCurrentValidator(
 		constants.PrimaryNetworkID,
 		vm.ctx.NodeID,
 	)
+	isPrimaryValidator := err == nil
 	switch err {
 	case nil:
 		vm.metrics.SetTimeUntilUnstake(time.Until(localPrimaryValidator.EndTime))
@@ -40,5 +44,49 @@ func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 			return nil, fmt.Errorf("couldn't get current subnet validator of %q: %w", subnetID, err)
 		}
 	}
+
+	if isPrimaryValidator {
+		return nil, vm.checkBlockProduction()
+	}
 	return nil, nil
 }
+
+// checkBlockProduction returns an error if no block has been accepted within
+// the configured staleness window even though this node should be producing
+// blocks. This node is expected to produce blocks if it is bootstrapped, has
+// transactions waiting to be included in a block, and is connected to other
+// primary network validators.
+//
+// Invariant: Assumes this node is a current primary network validator.
+func (vm *VM) checkBlockProduction() error {
+	if vm.blockStalenessWindow == 0 || !vm.bootstrapped.Get() || vm.Builder.Len() == 0 {
+		return nil
+	}
+	if !vm.isConnectedToPrimaryValidator() {
+		return nil
+	}
+
+	// The chain time is only advanced when a block is accepted, and every
+	// block built by an honest validator is timestamped with its local time.
+	lastAcceptedTime := vm.state.GetTimestamp()
+	timeSinceLastAccepted := vm.clock.Time().Sub(lastAcceptedTime)
+	if timeSinceLastAccepted <= vm.blockStalenessWindow {
+		return nil
+	}
+	return fmt.Errorf("%w: no block accepted for %s with %d transactions pending",
+		errBlockProductionStalled,
+		timeSinceLastAccepted,
+		vm.Builder.Len(),
+	)
+}
+
+// isConnectedToPrimaryValidator returns true if this node is connected to at
+// least one other primary network validator.
+func (vm *VM) isConnectedToPrimaryValidator() bool {
+	for _, nodeID := range vm.Validators.GetValidatorIDs(constants.PrimaryNetworkID) {
+		if nodeID != vm.ctx.NodeID && vm.uptimeManager.IsConnected(nodeID, constants.PrimaryNetworkID) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestHealthCheckBlockProductionStalled(t *testing.T) {
+	require := require.New(t)
+	vm, txBuilder, _, _ := defaultVM(t, latestFork)
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	// Pretend that this node is a genesis validator.
+	vm.ctx.NodeID = genesisNodeIDs[0]
+	window := config.DefaultExecutionConfig.BlockStalenessWindow
+	require.Equal(window, vm.blockStalenessWindow)
+
+	// A node without pending transactions isn't expected to produce blocks.
+	vm.clock.Set(vm.state.GetTimestamp().Add(window + 1))
+	_, err := vm.HealthCheck(context.Background())
+	require.NoError(err)
+
+	tx, err := txBuilder.NewCreateSubnetTx(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+		},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+	vm.ctx.Lock.Unlock()
+	require.NoError(vm.issueTxFromRPC(tx))
+	vm.ctx.Lock.Lock()
+
+	// A node that isn't connected to any other validator isn't expected to
+	// produce blocks.
+	_, err = vm.HealthCheck(context.Background())
+	require.NoError(err)
+
+	require.NoError(vm.Connected(context.Background(), genesisNodeIDs[1], version.CurrentApp))
+
+	_, err = vm.HealthCheck(context.Background())
+	require.ErrorIs(err, errBlockProductionStalled)
+
+	// The chain is healthy while within the staleness window.
+	vm.clock.Set(vm.state.GetTimestamp().Add(window))
+	_, err = vm.HealthCheck(context.Background())
+	require.NoError(err)
+}
@@ -38,6 +38,11 @@ type Metrics interface {
 	SetTimeUntilUnstake(time.Duration)
 	// Mark when this node will unstake from a subnet.
 	SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration)
+	// Mark that a block was built with txs left in the mempool because the
+	// max block size was reached.
+	IncBlocksTrimmed()
+	// Mark that this many verified blocks are held in memory.
+	SetBlocksInMemory(int)
 }
 
 func New(
@@ -91,6 +96,17 @@ func New(
 			Name:      "validator_sets_duration_sum",
 			Help:      "Total amount of time generating validator sets in nanoseconds",
 		}),
+
+		blocksTrimmed: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "blocks_trimmed",
+			Help:      "Total number of blocks built with txs left in the mempool because the max block size was reached",
+		}),
+		blocksInMemory: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "blocks_in_memory",
+			Help:      "Number of verified blocks held in memory until they are decided",
+		}),
 	}
 
 	errs := wrappers.Errs{Err: err}
@@ -107,6 +123,9 @@ func New(
 		registerer.Register(m.validatorSetsCached),
 		registerer.Register(m.validatorSetsHeightDiff),
 		registerer.Register(m.validatorSetsDuration),
+
+		registerer.Register(m.blocksTrimmed),
+		registerer.Register(m.blocksInMemory),
 	)
 
 	return m, errs.Err
@@ -126,6 +145,9 @@ type metrics struct {
 	validatorSetsCreated    prometheus.Counter
 	validatorSetsHeightDiff prometheus.Gauge
 	validatorSetsDuration   prometheus.Gauge
+
+	blocksTrimmed  prometheus.Counter
+	blocksInMemory prometheus.Gauge
 }
 
 func (m *metrics) MarkAccepted(b block.Block) error {
@@ -163,3 +185,11 @@ func (m *metrics) SetTimeUntilUnstake(timeUntilUnstake time.Duration) {
 func (m *metrics) SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration) {
 	m.timeUntilSubnetUnstake.WithLabelValues(subnetID.String()).Set(float64(timeUntilUnstake))
 }
+
+func (m *metrics) IncBlocksTrimmed() {
+	m.blocksTrimmed.Inc()
+}
+
+func (m *metrics) SetBlocksInMemory(n int) {
+	m.blocksInMemory.Set(float64(n))
+}
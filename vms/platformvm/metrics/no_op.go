@@ -50,3 +50,7 @@ func (noopMetrics) SetTimeUntilSubnetUnstake(ids.ID, time.Duration) {}
 func (noopMetrics) SetSubnetPercentConnected(ids.ID, float64) {}
 
 func (noopMetrics) SetPercentConnected(float64) {}
+
+func (noopMetrics) IncBlocksTrimmed() {}
+
+func (noopMetrics) SetBlocksInMemory(int) {}
@@ -11,6 +11,7 @@ import (
 	"maps"
 	"math"
 	"net/http"
+	"slices"
 	"time"
 
 	"go.uber.org/zap"
@@ -25,10 +26,13 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/keystore"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
@@ -53,6 +57,13 @@ const (
 	// Max number of items allowed in a page
 	maxPageSize = 1024
 
+	// Max number of UTXOs examined by a call to GetUTXOs that only returns
+	// spendable UTXOs
+	maxSpendableUTXOsScanned = 4 * maxPageSize
+
+	// Max number of block IDs that can be passed in as argument to GetBlocks
+	maxGetBlocksIDs = 64
+
 	// Note: Staker attributes cache should be large enough so that no evictions
 	// happen when the API loops through all stakers.
 	stakerAttributesCacheSize = 100_000
@@ -63,13 +74,14 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errTooManyBlockIDs            = errors.New("too many block IDs provided")
 )
 
 // Service defines the API calls that can be made to the platform chain
 type Service struct {
 	vm                    *VM
 	addrManager           avax.AddressManager
-	stakerAttributesCache *cache.LRU[ids.ID, *stakerAttributes]
+	stakerAttributesCache cache.Cacher[ids.ID, *stakerAttributes]
 }
 
 // All attributes are optional and may not be filled for each stakerTx.
@@ -318,8 +330,16 @@ type Index struct {
 	UTXO    string `json:"utxo"`    // The UTXO ID as a string
 }
 
+// GetUTXOsArgs are the arguments to GetUTXOs
+type GetUTXOsArgs struct {
+	api.GetUTXOsArgs
+	// If true, only the UTXOs that [Addresses] are able to spend at the
+	// current chain time are returned.
+	SpendableOnly bool `json:"spendableOnly"`
+}
+
 // GetUTXOs returns the UTXOs controlled by the given addresses
-func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *api.GetUTXOsReply) error {
+func (s *Service) GetUTXOs(_ *http.Request, args *GetUTXOsArgs, response *api.GetUTXOsReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getUTXOs"),
@@ -374,27 +394,29 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
-	if sourceChain == s.vm.ctx.ChainID {
-		utxos, endAddr, endUTXOID, err = avax.GetPaginatedUTXOs(
-			s.vm.state,
+	if !args.SpendableOnly {
+		utxos, endAddr, endUTXOID, err = s.getPaginatedUTXOs(
+			sourceChain,
 			addrSet,
 			startAddr,
 			startUTXO,
 			limit,
 		)
+		if err != nil {
+			return fmt.Errorf("problem retrieving UTXOs: %w", err)
+		}
 	} else {
-		utxos, endAddr, endUTXOID, err = avax.GetAtomicUTXOs(
-			s.vm.ctx.SharedMemory,
-			txs.Codec,
+		utxos, endAddr, endUTXOID, err = s.getSpendableUTXOs(
 			sourceChain,
 			addrSet,
 			startAddr,
 			startUTXO,
 			limit,
+			maxSpendableUTXOsScanned,
 		)
-	}
-	if err != nil {
-		return fmt.Errorf("problem retrieving UTXOs: %w", err)
+		if err != nil {
+			return fmt.Errorf("problem retrieving UTXOs: %w", err)
+		}
 	}
 
 	response.UTXOs = make([]string, len(utxos))
@@ -421,6 +443,114 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	return nil
 }
 
+func (s *Service) getPaginatedUTXOs(
+	sourceChain ids.ID,
+	addrs set.Set[ids.ShortID],
+	startAddr ids.ShortID,
+	startUTXOID ids.ID,
+	limit int,
+) ([]*avax.UTXO, ids.ShortID, ids.ID, error) {
+	if sourceChain == s.vm.ctx.ChainID {
+		return avax.GetPaginatedUTXOs(
+			s.vm.state,
+			addrs,
+			startAddr,
+			startUTXOID,
+			limit,
+		)
+	}
+	return avax.GetAtomicUTXOs(
+		s.vm.ctx.SharedMemory,
+		txs.Codec,
+		sourceChain,
+		addrs,
+		startAddr,
+		startUTXOID,
+		limit,
+	)
+}
+
+// getSpendableUTXOs returns up to [limit] UTXOs that [addrs] are able to spend
+// at the current chain time. Pages of UTXOs are fetched until [limit] spendable
+// UTXOs have been found, there are no more UTXOs or [maxScanned] UTXOs have
+// been examined. The returned address and UTXO ID are the last UTXO examined,
+// so the next call resumes after the UTXOs that were filtered out.
+//
+// Assumes the context lock is held.
+func (s *Service) getSpendableUTXOs(
+	sourceChain ids.ID,
+	addrs set.Set[ids.ShortID],
+	startAddr ids.ShortID,
+	startUTXOID ids.ID,
+	limit int,
+	maxScanned int,
+) ([]*avax.UTXO, ids.ShortID, ids.ID, error) {
+	var (
+		utxos       []*avax.UTXO
+		numScanned  int
+		endAddr     = startAddr
+		endUTXOID   = startUTXOID
+		currentTime = uint64(s.vm.state.GetTimestamp().Unix())
+	)
+	for len(utxos) < limit && numScanned < maxScanned {
+		numToScan := min(limit-len(utxos), maxScanned-numScanned)
+		page, pageEndAddr, pageEndUTXOID, err := s.getPaginatedUTXOs(
+			sourceChain,
+			addrs,
+			endAddr,
+			endUTXOID,
+			numToScan,
+		)
+		if err != nil {
+			return nil, ids.ShortID{}, ids.Empty, err
+		}
+		endAddr, endUTXOID = pageEndAddr, pageEndUTXOID
+		numScanned += len(page)
+
+		for _, utxo := range page {
+			if isSpendable(utxo, addrs, currentTime) {
+				utxos = append(utxos, utxo)
+			}
+		}
+		if len(page) < numToScan {
+			// There are no more UTXOs
+			break
+		}
+	}
+	return utxos, endAddr, endUTXOID, nil
+}
+
+// isSpendable returns true if [addrs] are able to spend all of the funds in
+// [utxo] at [currentTime], without any of them remaining stakeable locked.
+func isSpendable(utxo *avax.UTXO, addrs set.Set[ids.ShortID], currentTime uint64) bool {
+	out := utxo.Out
+	if lockedOut, ok := out.(*stakeable.LockOut); ok {
+		if lockedOut.Locktime > currentTime {
+			return false
+		}
+		out = lockedOut.TransferableOut
+	}
+
+	transferOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return false
+	}
+	owners := &transferOut.OutputOwners
+	if owners.Locktime > currentTime {
+		return false
+	}
+
+	// [owners.Addrs] is sorted and unique, so each address can sign at most
+	// once.
+	var numSigners uint32
+	for _, addr := range owners.Addrs {
+		if addrs.Contains(addr) {
+			numSigners++
+		}
+	}
+	return numSigners >= owners.Threshold
+}
+
 // GetSubnetArgs are the arguments to GetSubnet
 type GetSubnetArgs struct {
 	// ID of the subnet to retrieve information about
@@ -545,7 +675,10 @@ func (s *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, response *Ge
 			}
 
 			unsignedTx := subnet.Unsigned.(*txs.CreateSubnetTx)
-			owner := unsignedTx.Owner.(*secp256k1fx.OutputOwners)
+			owner, ok := unsignedTx.Owner.(*secp256k1fx.OutputOwners)
+			if !ok {
+				return fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", unsignedTx.Owner)
+			}
 			controlAddrs := []string{}
 			for _, controlKeyID := range owner.Addrs {
 				addr, err := s.addrManager.FormatLocalAddress(controlKeyID)
@@ -1639,9 +1772,15 @@ func (s *Service) GetRewardUTXOs(_ *http.Request, args *api.GetTxArgs, reply *Ge
 type GetTimestampReply struct {
 	// Current timestamp
 	Timestamp time.Time `json:"timestamp"`
+	// Current timestamp in seconds since the Unix epoch
+	UnixTimestamp avajson.Uint64 `json:"unixTimestamp"`
+	// Height of the last accepted block, whose state the timestamp reflects
+	Height avajson.Uint64 `json:"height"`
 }
 
-// GetTimestamp returns the current timestamp on chain.
+// GetTimestamp returns the current timestamp on chain, along with the height
+// of the last accepted block it corresponds to. The chain time may lag behind
+// the wall clock.
 func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestampReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1651,10 +1790,83 @@ func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestamp
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
+	lastAcceptedID := s.vm.manager.LastAccepted()
+	lastAccepted, err := s.vm.manager.GetStatelessBlock(lastAcceptedID)
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+
+	timestamp := s.vm.state.GetTimestamp()
+	reply.Timestamp = timestamp
+	reply.UnixTimestamp = avajson.Uint64(timestamp.Unix())
+	reply.Height = avajson.Uint64(lastAccepted.Height())
+	return nil
+}
+
+// GetValidatorSetHashArgs are the arguments for calling GetValidatorSetHash
+type GetValidatorSetHashArgs struct {
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// GetValidatorSetHashReply is the response from calling GetValidatorSetHash
+type GetValidatorSetHashReply struct {
+	// Hash of the current validator set of the subnet
+	Hash ids.ID `json:"hash"`
+	// Number of validators in the current validator set of the subnet
+	NumValidators avajson.Uint64 `json:"numValidators"`
+	// Chain time the validator set corresponds to
+	Timestamp time.Time `json:"timestamp"`
+	// Height of the last accepted block the validator set corresponds to
+	Height avajson.Uint64 `json:"height"`
+}
+
+// GetValidatorSetHash returns a deterministic hash of the current validator
+// set of a subnet, along with the chain time and height it corresponds to.
+// Nodes that agree on the validator set at the same height report the same
+// hash.
+func (s *Service) GetValidatorSetHash(_ *http.Request, args *GetValidatorSetHashArgs, reply *GetValidatorSetHashReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorSetHash"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	lastAcceptedID := s.vm.manager.LastAccepted()
+	lastAccepted, err := s.vm.manager.GetStatelessBlock(lastAcceptedID)
+	if err != nil {
+		return fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+
+	vdrs := s.vm.Validators.GetMap(args.SubnetID)
+	reply.Hash = validatorSetHash(vdrs)
+	reply.NumValidators = avajson.Uint64(len(vdrs))
 	reply.Timestamp = s.vm.state.GetTimestamp()
+	reply.Height = avajson.Uint64(lastAccepted.Height())
 	return nil
 }
 
+// validatorSetHash hashes the node IDs and weights of [vdrs], sorted by node
+// ID.
+func validatorSetHash(vdrs map[ids.NodeID]*validators.GetValidatorOutput) ids.ID {
+	nodeIDs := make([]ids.NodeID, 0, len(vdrs))
+	for nodeID := range vdrs {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	slices.SortFunc(nodeIDs, ids.NodeID.Compare)
+
+	p := wrappers.Packer{
+		Bytes: make([]byte, len(nodeIDs)*(ids.NodeIDLen+wrappers.LongLen)),
+	}
+	for _, nodeID := range nodeIDs {
+		p.PackFixedBytes(nodeID[:])
+		p.PackLong(vdrs[nodeID].Weight)
+	}
+	return hashing.ComputeHash256Array(p.Bytes)
+}
+
 // GetValidatorsAtArgs is the response from GetValidatorsAt
 type GetValidatorsAtArgs struct {
 	Height   avajson.Uint64 `json:"height"`
@@ -1764,20 +1976,63 @@ func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *ap
 		return fmt.Errorf("couldn't get block with id %s: %w", args.BlockID, err)
 	}
 	response.Encoding = args.Encoding
+	response.Block, err = s.marshalBlock(block, args.Encoding)
+	return err
+}
 
-	var result any
-	if args.Encoding == formatting.JSON {
-		block.InitCtx(s.vm.ctx)
-		result = block
-	} else {
-		result, err = formatting.Encode(args.Encoding, block.Bytes())
+// GetBlocksArgs are the arguments for calling GetBlocks
+type GetBlocksArgs struct {
+	BlockIDs []ids.ID            `json:"blockIDs"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetBlocksReply is the response from calling GetBlocks
+type GetBlocksReply struct {
+	// Blocks that were found, in the order they were requested.
+	Blocks   []json.RawMessage   `json:"blocks"`
+	Encoding formatting.Encoding `json:"encoding"`
+	// MissingBlockIDs are the requested block IDs that this node doesn't
+	// know about.
+	MissingBlockIDs []ids.ID `json:"missingBlockIDs"`
+}
+
+// GetBlocks returns the requested blocks. Unknown block IDs are reported in
+// [MissingBlockIDs] rather than failing the whole request.
+func (s *Service) GetBlocks(_ *http.Request, args *GetBlocksArgs, response *GetBlocksReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getBlocks"),
+		zap.Int("numBlockIDs", len(args.BlockIDs)),
+		zap.Stringer("encoding", args.Encoding),
+	)
+
+	if len(args.BlockIDs) > maxGetBlocksIDs {
+		return fmt.Errorf("%w: %d provided but this method can take at most %d", errTooManyBlockIDs, len(args.BlockIDs), maxGetBlocksIDs)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	response.Blocks = make([]json.RawMessage, 0, len(args.BlockIDs))
+	response.Encoding = args.Encoding
+	response.MissingBlockIDs = []ids.ID{}
+	for _, blkID := range args.BlockIDs {
+		block, err := s.vm.manager.GetStatelessBlock(blkID)
+		if errors.Is(err, database.ErrNotFound) {
+			response.MissingBlockIDs = append(response.MissingBlockIDs, blkID)
+			continue
+		}
 		if err != nil {
-			return fmt.Errorf("couldn't encode block %s as %s: %w", args.BlockID, args.Encoding, err)
+			return fmt.Errorf("couldn't get block with id %s: %w", blkID, err)
 		}
-	}
 
-	response.Block, err = json.Marshal(result)
-	return err
+		blockBytes, err := s.marshalBlock(block, args.Encoding)
+		if err != nil {
+			return err
+		}
+		response.Blocks = append(response.Blocks, blockBytes)
+	}
+	return nil
 }
 
 // GetBlockByHeight returns the block at the given height.
@@ -1806,20 +2061,24 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 		return fmt.Errorf("couldn't get block with id %s: %w", blockID, err)
 	}
 	response.Encoding = args.Encoding
+	response.Block, err = s.marshalBlock(block, args.Encoding)
+	return err
+}
 
+// marshalBlock returns the JSON representation of [blk] in [encoding].
+func (s *Service) marshalBlock(blk block.Block, encoding formatting.Encoding) (json.RawMessage, error) {
 	var result any
-	if args.Encoding == formatting.JSON {
-		block.InitCtx(s.vm.ctx)
-		result = block
+	if encoding == formatting.JSON {
+		blk.InitCtx(s.vm.ctx)
+		result = blk
 	} else {
-		result, err = formatting.Encode(args.Encoding, block.Bytes())
+		var err error
+		result, err = formatting.Encode(encoding, blk.Bytes())
 		if err != nil {
-			return fmt.Errorf("couldn't encode block %s as %s: %w", blockID, args.Encoding, err)
+			return nil, fmt.Errorf("couldn't encode block %s as %s: %w", blk.ID(), encoding, err)
 		}
 	}
-
-	response.Block, err = json.Marshal(result)
-	return err
+	return json.Marshal(result)
 }
 
 func (s *Service) getAPIUptime(staker *state.Staker) (*avajson.Float32, error) {
@@ -36,6 +36,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -45,6 +46,7 @@ import (
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 
 	avajson "github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/set"
 	vmkeystore "github.com/ava-labs/avalanchego/vms/components/keystore"
 	pchainapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
@@ -396,6 +398,126 @@ func TestGetBalance(t *testing.T) {
 	}
 }
 
+func TestGetUTXOsSpendableOnly(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+
+	var (
+		addr        = ids.GenerateTestShortID()
+		otherAddr   = ids.GenerateTestShortID()
+		currentTime = uint64(service.vm.state.GetTimestamp().Unix())
+		newUTXO     = func(out avax.TransferableOut) *avax.UTXO {
+			return &avax.UTXO{
+				UTXOID: avax.UTXOID{
+					TxID: ids.GenerateTestID(),
+				},
+				Asset: avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+				Out:   out,
+			}
+		}
+		newOut = func(locktime uint64, threshold uint32, addrs ...ids.ShortID) *secp256k1fx.TransferOutput {
+			return &secp256k1fx.TransferOutput{
+				Amt: defaultBalance,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  locktime,
+					Threshold: threshold,
+					Addrs:     addrs,
+				},
+			}
+		}
+
+		spendableUTXO = newUTXO(newOut(currentTime, 1, addr))
+		utxos         = []*avax.UTXO{
+			spendableUTXO,
+			// locked
+			newUTXO(newOut(currentTime+1, 1, addr)),
+			// stakeable locked
+			newUTXO(&stakeable.LockOut{
+				Locktime:        currentTime + 1,
+				TransferableOut: newOut(0, 1, addr),
+			}),
+			// threshold can't be met
+			newUTXO(newOut(0, 2, addr, otherAddr)),
+		}
+	)
+	for _, utxo := range utxos {
+		service.vm.state.AddUTXO(utxo)
+	}
+	require.NoError(service.vm.state.Commit())
+
+	service.vm.ctx.Lock.Unlock()
+
+	address, err := service.addrManager.FormatLocalAddress(addr)
+	require.NoError(err)
+
+	args := GetUTXOsArgs{
+		GetUTXOsArgs: api.GetUTXOsArgs{
+			Addresses: []string{address},
+			Encoding:  formatting.Hex,
+		},
+	}
+	reply := api.GetUTXOsReply{}
+	require.NoError(service.GetUTXOs(nil, &args, &reply))
+	require.Equal(avajson.Uint64(len(utxos)), reply.NumFetched)
+
+	args.SpendableOnly = true
+	reply = api.GetUTXOsReply{}
+	require.NoError(service.GetUTXOs(nil, &args, &reply))
+	require.Equal(avajson.Uint64(1), reply.NumFetched)
+
+	utxoBytes, err := formatting.Decode(reply.Encoding, reply.UTXOs[0])
+	require.NoError(err)
+
+	var utxo avax.UTXO
+	_, err = txs.Codec.Unmarshal(utxoBytes, &utxo)
+	require.NoError(err)
+	require.Equal(spendableUTXO.InputID(), utxo.InputID())
+
+	// Non-spendable UTXOs don't count towards the limit
+	args.Limit = 1
+	reply = api.GetUTXOsReply{}
+	require.NoError(service.GetUTXOs(nil, &args, &reply))
+	require.Equal(avajson.Uint64(1), reply.NumFetched)
+
+	// When the number of UTXOs examined is capped, the returned cursor
+	// resumes after the last examined UTXO.
+	service.vm.ctx.Lock.Lock()
+	defer service.vm.ctx.Lock.Unlock()
+
+	var (
+		addrSet     = set.Of(addr)
+		startAddr   ids.ShortID
+		startUTXOID ids.ID
+		found       []*avax.UTXO
+		numCalls    int
+		sourceChain = service.vm.ctx.ChainID
+		maxScanned  = 1
+	)
+	for {
+		page, endAddr, endUTXOID, err := service.getSpendableUTXOs(
+			sourceChain,
+			addrSet,
+			startAddr,
+			startUTXOID,
+			len(utxos),
+			maxScanned,
+		)
+		require.NoError(err)
+		require.LessOrEqual(len(page), maxScanned)
+		if endAddr == startAddr && endUTXOID == startUTXOID {
+			break
+		}
+		found = append(found, page...)
+		startAddr, startUTXOID = endAddr, endUTXOID
+		numCalls++
+	}
+	require.Equal(len(utxos), numCalls)
+	require.Len(found, 1)
+	require.Equal(spendableUTXO.InputID(), found[0].InputID())
+}
+
 func TestGetStake(t *testing.T) {
 	require := require.New(t)
 	service, _, txBuilder := defaultService(t)
@@ -725,6 +847,11 @@ func TestGetTimestamp(t *testing.T) {
 	service.vm.ctx.Lock.Lock()
 
 	require.Equal(service.vm.state.GetTimestamp(), reply.Timestamp)
+	require.Equal(avajson.Uint64(reply.Timestamp.Unix()), reply.UnixTimestamp)
+
+	lastAccepted, err := service.vm.manager.GetStatelessBlock(service.vm.manager.LastAccepted())
+	require.NoError(err)
+	require.Equal(avajson.Uint64(lastAccepted.Height()), reply.Height)
 
 	newTimestamp := reply.Timestamp.Add(time.Second)
 	service.vm.state.SetTimestamp(newTimestamp)
@@ -733,6 +860,54 @@ func TestGetTimestamp(t *testing.T) {
 
 	require.NoError(service.GetTimestamp(nil, nil, &reply))
 	require.Equal(newTimestamp, reply.Timestamp)
+	require.Equal(avajson.Uint64(newTimestamp.Unix()), reply.UnixTimestamp)
+}
+
+func TestGetValidatorSetHash(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	args := GetValidatorSetHashArgs{
+		SubnetID: constants.PrimaryNetworkID,
+	}
+	reply := GetValidatorSetHashReply{}
+	require.NoError(service.GetValidatorSetHash(nil, &args, &reply))
+	require.Equal(avajson.Uint64(len(genesisNodeIDs)), reply.NumValidators)
+
+	service.vm.ctx.Lock.Lock()
+	require.Equal(service.vm.state.GetTimestamp(), reply.Timestamp)
+	lastAccepted, err := service.vm.manager.GetStatelessBlock(service.vm.manager.LastAccepted())
+	require.NoError(err)
+	require.Equal(avajson.Uint64(lastAccepted.Height()), reply.Height)
+
+	// Changing a validator's weight must change the hash
+	require.NoError(service.vm.Validators.AddWeight(constants.PrimaryNetworkID, genesisNodeIDs[0], 1))
+	service.vm.ctx.Lock.Unlock()
+
+	newReply := GetValidatorSetHashReply{}
+	require.NoError(service.GetValidatorSetHash(nil, &args, &newReply))
+	require.NotEqual(reply.Hash, newReply.Hash)
+}
+
+func TestValidatorSetHashIsDeterministic(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := make(map[ids.NodeID]*validators.GetValidatorOutput)
+	for i := 0; i < 10; i++ {
+		nodeID := ids.GenerateTestNodeID()
+		vdrs[nodeID] = &validators.GetValidatorOutput{
+			NodeID: nodeID,
+			Weight: uint64(i + 1),
+		}
+	}
+
+	// Map iteration order is randomized, so hashing repeatedly verifies that
+	// the hash doesn't depend on it.
+	expectedHash := validatorSetHash(vdrs)
+	for i := 0; i < 10; i++ {
+		require.Equal(expectedHash, validatorSetHash(vdrs))
+	}
+	require.NotEqual(expectedHash, validatorSetHash(nil))
 }
 
 func TestGetBlock(t *testing.T) {
@@ -818,6 +993,46 @@ func TestGetBlock(t *testing.T) {
 	}
 }
 
+func TestGetBlocks(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	lastAcceptedID := service.vm.manager.LastAccepted()
+	lastAccepted, err := service.vm.manager.GetStatelessBlock(lastAcceptedID)
+	require.NoError(err)
+
+	missingID := ids.GenerateTestID()
+	args := GetBlocksArgs{
+		BlockIDs: []ids.ID{lastAcceptedID, missingID},
+		Encoding: formatting.Hex,
+	}
+	response := GetBlocksReply{}
+	require.NoError(service.GetBlocks(nil, &args, &response))
+
+	require.Equal(formatting.Hex, response.Encoding)
+	require.Equal([]ids.ID{missingID}, response.MissingBlockIDs)
+	require.Len(response.Blocks, 1)
+
+	var blockStr string
+	require.NoError(json.Unmarshal(response.Blocks[0], &blockStr))
+	blockBytes, err := formatting.Decode(response.Encoding, blockStr)
+	require.NoError(err)
+	require.Equal(lastAccepted.Bytes(), blockBytes)
+}
+
+func TestGetBlocksTooManyIDs(t *testing.T) {
+	require := require.New(t)
+	service, _, _ := defaultService(t)
+
+	args := GetBlocksArgs{
+		BlockIDs: make([]ids.ID, maxGetBlocksIDs+1),
+		Encoding: formatting.Hex,
+	}
+	response := GetBlocksReply{}
+	err := service.GetBlocks(nil, &args, &response)
+	require.ErrorIs(err, errTooManyBlockIDs)
+}
+
 func TestGetValidatorsAtReplyMarshalling(t *testing.T) {
 	require := require.New(t)
 
@@ -6,10 +6,12 @@ package state
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -27,6 +29,30 @@ type Diff interface {
 	Chain
 
 	Apply(Chain) error
+
+	// Changes returns the modifications that Apply would write to the parent
+	// state.
+	Changes() *Changes
+}
+
+// Changes are the UTXO and staker set modifications of a Diff relative to its
+// parent state.
+type Changes struct {
+	Timestamp time.Time
+
+	// UTXOs created by the diff, sorted by UTXO ID.
+	ProducedUTXOs []*avax.UTXO
+	// IDs of the UTXOs removed from the parent state by the diff, sorted.
+	// UTXOs that are both produced and consumed by the diff are in neither
+	// list.
+	ConsumedUTXOs []ids.ID
+
+	// Stakers added to and removed from the current and pending staker sets,
+	// sorted by the order in which they are removed from the staker set.
+	AddedCurrentStakers   []*Staker
+	RemovedCurrentStakers []*Staker
+	AddedPendingStakers   []*Staker
+	RemovedPendingStakers []*Staker
 }
 
 type diff struct {
@@ -57,6 +83,9 @@ type diff struct {
 
 	// map of modified UTXOID -> *UTXO if the UTXO is nil, it has been removed
 	modifiedUTXOs map[ids.ID]*avax.UTXO
+	// IDs of the UTXOs added by this diff, including those that have since
+	// been removed
+	addedUTXOs set.Set[ids.ID]
 }
 
 func NewDiff(
@@ -380,6 +409,7 @@ func (d *diff) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
 }
 
 func (d *diff) AddUTXO(utxo *avax.UTXO) {
+	d.addedUTXOs.Add(utxo.InputID())
 	if d.modifiedUTXOs == nil {
 		d.modifiedUTXOs = map[ids.ID]*avax.UTXO{
 			utxo.InputID(): utxo,
@@ -399,6 +429,29 @@ func (d *diff) DeleteUTXO(utxoID ids.ID) {
 	}
 }
 
+func (d *diff) Changes() *Changes {
+	changes := &Changes{
+		Timestamp: d.timestamp,
+	}
+	for utxoID, utxo := range d.modifiedUTXOs {
+		switch {
+		case utxo != nil:
+			changes.ProducedUTXOs = append(changes.ProducedUTXOs, utxo)
+		case !d.addedUTXOs.Contains(utxoID):
+			// Only report UTXOs that were consumed from the parent state.
+			changes.ConsumedUTXOs = append(changes.ConsumedUTXOs, utxoID)
+		}
+	}
+	slices.SortFunc(changes.ProducedUTXOs, func(a, b *avax.UTXO) int {
+		return a.InputID().Compare(b.InputID())
+	})
+	slices.SortFunc(changes.ConsumedUTXOs, ids.ID.Compare)
+
+	changes.AddedCurrentStakers, changes.RemovedCurrentStakers = d.currentStakerDiffs.changes()
+	changes.AddedPendingStakers, changes.RemovedPendingStakers = d.pendingStakerDiffs.changes()
+	return changes
+}
+
 func (d *diff) Apply(baseState Chain) error {
 	baseState.SetTimestamp(d.timestamp)
 	for subnetID, supply := range d.currentSupply {
@@ -471,9 +524,12 @@ func (d *diff) Apply(baseState Chain) error {
 		}
 	}
 	for utxoID, utxo := range d.modifiedUTXOs {
-		if utxo != nil {
+		switch {
+		case utxo != nil:
 			baseState.AddUTXO(utxo)
-		} else {
+		case !d.addedUTXOs.Contains(utxoID):
+			// UTXOs produced and consumed by this diff never existed in
+			// [baseState].
 			baseState.DeleteUTXO(utxoID)
 		}
 	}
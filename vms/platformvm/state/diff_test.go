@@ -488,6 +488,104 @@ func TestDiffUTXO(t *testing.T) {
 	}
 }
 
+func TestDiffChanges(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	state := NewMockState(ctrl)
+	// Called in NewDiff
+	state.EXPECT().GetTimestamp().Return(time.Now()).Times(1)
+
+	states := NewMockVersions(ctrl)
+	lastAcceptedID := ids.GenerateTestID()
+	states.EXPECT().GetState(lastAcceptedID).Return(state, true).AnyTimes()
+
+	d, err := NewDiff(lastAcceptedID, states)
+	require.NoError(err)
+
+	// An empty diff doesn't change anything
+	changes := d.Changes()
+	require.Empty(changes.ProducedUTXOs)
+	require.Empty(changes.ConsumedUTXOs)
+	require.Empty(changes.AddedCurrentStakers)
+	require.Empty(changes.RemovedCurrentStakers)
+	require.Empty(changes.AddedPendingStakers)
+	require.Empty(changes.RemovedPendingStakers)
+
+	timestamp := time.Unix(1, 0)
+	d.SetTimestamp(timestamp)
+
+	producedUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+	}
+	d.AddUTXO(producedUTXO)
+	consumedUTXOID := ids.GenerateTestID()
+	d.DeleteUTXO(consumedUTXOID)
+
+	// A UTXO that is produced and consumed by the diff never existed in the
+	// parent state, so it isn't reported.
+	transientUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+	}
+	d.AddUTXO(transientUTXO)
+	d.DeleteUTXO(transientUTXO.InputID())
+
+	addedValidator := &Staker{
+		TxID:     ids.GenerateTestID(),
+		SubnetID: ids.GenerateTestID(),
+		NodeID:   ids.GenerateTestNodeID(),
+		NextTime: timestamp.Add(time.Hour),
+	}
+	d.PutCurrentValidator(addedValidator)
+	addedDelegator := &Staker{
+		TxID:     ids.GenerateTestID(),
+		SubnetID: addedValidator.SubnetID,
+		NodeID:   addedValidator.NodeID,
+		NextTime: timestamp.Add(time.Minute),
+	}
+	d.PutCurrentDelegator(addedDelegator)
+
+	removedValidator := &Staker{
+		TxID:     ids.GenerateTestID(),
+		SubnetID: ids.GenerateTestID(),
+		NodeID:   ids.GenerateTestNodeID(),
+	}
+	d.DeletePendingValidator(removedValidator)
+
+	// A validator that is added and removed by the diff isn't changed.
+	transientValidator := &Staker{
+		TxID:     ids.GenerateTestID(),
+		SubnetID: ids.GenerateTestID(),
+		NodeID:   ids.GenerateTestNodeID(),
+	}
+	d.PutPendingValidator(transientValidator)
+	d.DeletePendingValidator(transientValidator)
+
+	changes = d.Changes()
+	require.Equal(timestamp, changes.Timestamp)
+	require.Equal([]*avax.UTXO{producedUTXO}, changes.ProducedUTXOs)
+	require.Equal([]ids.ID{consumedUTXOID}, changes.ConsumedUTXOs)
+	require.Equal([]*Staker{addedDelegator, addedValidator}, changes.AddedCurrentStakers)
+	require.Empty(changes.RemovedCurrentStakers)
+	require.Empty(changes.AddedPendingStakers)
+	require.Equal([]*Staker{removedValidator}, changes.RemovedPendingStakers)
+
+	// Applying the diff onto another diff doesn't report the UTXO that was
+	// produced and consumed as consumed from the parent state.
+	childDiff, err := NewDiffOn(d)
+	require.NoError(err)
+	childTransientUTXO := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+	}
+	childDiff.AddUTXO(childTransientUTXO)
+	childDiff.DeleteUTXO(childTransientUTXO.InputID())
+	require.NoError(childDiff.Apply(d))
+
+	changes = d.Changes()
+	require.Equal([]*avax.UTXO{producedUTXO}, changes.ProducedUTXOs)
+	require.Equal([]ids.ID{consumedUTXOID}, changes.ConsumedUTXOs)
+}
+
 func assertChainsEqual(t *testing.T, expected, actual Chain) {
 	require := require.New(t)
 
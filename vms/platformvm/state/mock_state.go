@@ -584,6 +584,20 @@ func (mr *MockDiffMockRecorder) Apply(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockDiff)(nil).Apply), arg0)
 }
 
+// Changes mocks base method.
+func (m *MockDiff) Changes() *Changes {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Changes")
+	ret0, _ := ret[0].(*Changes)
+	return ret0
+}
+
+// Changes indicates an expected call of Changes.
+func (mr *MockDiffMockRecorder) Changes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Changes", reflect.TypeOf((*MockDiff)(nil).Changes))
+}
+
 // DeleteCurrentDelegator mocks base method.
 func (m *MockDiff) DeleteCurrentDelegator(arg0 *Staker) {
 	m.ctrl.T.Helper()
@@ -1578,6 +1592,20 @@ func (mr *MockStateMockRecorder) ReindexBlocks(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReindexBlocks", reflect.TypeOf((*MockState)(nil).ReindexBlocks), arg0, arg1)
 }
 
+// RebuildValidatorSets mocks base method.
+func (m *MockState) RebuildValidatorSets() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildValidatorSets")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildValidatorSets indicates an expected call of RebuildValidatorSets.
+func (mr *MockStateMockRecorder) RebuildValidatorSets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildValidatorSets", reflect.TypeOf((*MockState)(nil).RebuildValidatorSets))
+}
+
 // SetCurrentSupply mocks base method.
 func (m *MockState) SetCurrentSupply(arg0 ids.ID, arg1 uint64) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,8 @@
 package state
 
 import (
+	"slices"
+
 	"github.com/google/btree"
 
 	"github.com/ava-labs/avalanchego/database"
@@ -378,6 +380,46 @@ func (s *diffStakers) GetStakerIterator(parentIterator StakerIterator) StakerIte
 	)
 }
 
+// changes returns the stakers that are added and removed by this diff, sorted
+// by Staker.Less.
+func (s *diffStakers) changes() ([]*Staker, []*Staker) {
+	var addedStakers, removedStakers []*Staker
+	for _, subnetValidatorDiffs := range s.validatorDiffs {
+		for _, validatorDiff := range subnetValidatorDiffs {
+			switch validatorDiff.validatorStatus {
+			case added:
+				addedStakers = append(addedStakers, validatorDiff.validator)
+			case deleted:
+				removedStakers = append(removedStakers, validatorDiff.validator)
+			}
+
+			addedDelegatorIterator := NewTreeIterator(validatorDiff.addedDelegators)
+			for addedDelegatorIterator.Next() {
+				addedStakers = append(addedStakers, addedDelegatorIterator.Value())
+			}
+			addedDelegatorIterator.Release()
+
+			for _, delegator := range validatorDiff.deletedDelegators {
+				removedStakers = append(removedStakers, delegator)
+			}
+		}
+	}
+	slices.SortFunc(addedStakers, compareStakers)
+	slices.SortFunc(removedStakers, compareStakers)
+	return addedStakers, removedStakers
+}
+
+func compareStakers(a, b *Staker) int {
+	switch {
+	case a.Less(b):
+		return -1
+	case b.Less(a):
+		return 1
+	default:
+		return 0
+	}
+}
+
 func (s *diffStakers) getOrCreateDiff(subnetID ids.ID, nodeID ids.NodeID) *diffValidator {
 	if s.validatorDiffs == nil {
 		s.validatorDiffs = make(map[ids.ID]map[ids.NodeID]*diffValidator)
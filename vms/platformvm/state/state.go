@@ -31,6 +31,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -176,6 +177,14 @@ type State interface {
 
 	SetHeight(height uint64)
 
+	// RebuildValidatorSets clears the validator sets of the primary network
+	// and of every subnet and repopulates them from the current stakers.
+	//
+	// This is intended to recover from the validator sets diverging from the
+	// current stakers. While the sets are being rebuilt, readers of the
+	// validator sets may observe partially populated sets.
+	RebuildValidatorSets() error
+
 	// Discard uncommitted changes to the database.
 	Abort()
 
@@ -529,7 +538,7 @@ func newState(
 	}
 
 	utxoDB := prefixdb.New(UTXOPrefix, baseDB)
-	utxoState, err := avax.NewMeteredUTXOState(utxoDB, txs.GenesisCodec, metricsReg, execCfg.ChecksumsEnabled)
+	utxoState, err := avax.NewMeteredUTXOState(utxoDB, txs.GenesisCodec, metricsReg, execCfg.UTXOCacheSize, execCfg.ChecksumsEnabled)
 	if err != nil {
 		return nil, err
 	}
@@ -1626,6 +1635,29 @@ func (s *state) initValidatorSets() error {
 	return nil
 }
 
+func (s *state) RebuildValidatorSets() error {
+	subnetIDs := set.Of(constants.PrimaryNetworkID)
+	subnets, err := s.GetSubnets()
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		subnetIDs.Add(subnet.ID())
+	}
+	for subnetID := range s.currentStakers.validators {
+		subnetIDs.Add(subnetID)
+	}
+
+	for subnetID := range subnetIDs {
+		for nodeID, validator := range s.validators.GetMap(subnetID) {
+			if err := s.validators.RemoveWeight(subnetID, nodeID, validator.Weight); err != nil {
+				return fmt.Errorf("failed to remove %s from subnet %s: %w", nodeID, subnetID, err)
+			}
+		}
+	}
+	return s.initValidatorSets()
+}
+
 func (s *state) write(updateValidators bool, height uint64) error {
 	codecVersion := CodecVersion1
 	if !s.cfg.UpgradeConfig.IsDurangoActivated(s.GetTimestamp()) {
@@ -15,6 +15,11 @@ import (
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
+// DefaultMaxInputs is the default maximum number of inputs, including imported
+// inputs, a tx may consume to be added to the mempool. A tx with more inputs
+// than this would exceed the maximum tx size accepted into the mempool.
+const DefaultMaxInputs = 1024
+
 var (
 	_ UnsignedTx = (*BaseTx)(nil)
 
@@ -5,6 +5,7 @@ package txs
 
 import (
 	"errors"
+	"fmt"
 	"unicode"
 
 	"github.com/ava-labs/avalanchego/ids"
@@ -18,6 +19,10 @@ import (
 const (
 	MaxNameLen    = 128
 	MaxGenesisLen = units.MiB
+
+	// DefaultMaxFxIDs is the default maximum number of feature extensions a
+	// new chain may run.
+	DefaultMaxFxIDs = 32
 )
 
 var (
@@ -57,7 +62,7 @@ func (tx *CreateChainTx) SyntacticVerify(ctx *snow.Context) error {
 	case tx.SyntacticallyVerified: // already passed syntactic verification
 		return nil
 	case tx.SubnetID == constants.PrimaryNetworkID:
-		return ErrCantValidatePrimaryNetwork
+		return fmt.Errorf("%w: chain %q was assigned to subnet %s", ErrCantValidatePrimaryNetwork, tx.ChainName, tx.SubnetID)
 	case len(tx.ChainName) > MaxNameLen:
 		return errNameTooLong
 	case tx.VMID == ids.Empty:
@@ -565,6 +565,240 @@ func TestAdvanceTimeTxRemoveSubnetValidator(t *testing.T) {
 	require.False(ok)
 }
 
+func TestAdvanceTimeToSubnetValidatorRemovalGracePeriod(t *testing.T) {
+	const gracePeriod = 10 * time.Second
+
+	subnetVdrNodeID := genesisNodeIDs[0]
+	subnetVdrEndTime := defaultValidateStartTime.Add(defaultMinStakingDuration)
+
+	tests := []struct {
+		name              string
+		fork              fork
+		newChainTime      time.Time
+		expectedRemovalAt time.Time
+		expectedRemoved   bool
+	}{
+		{
+			name:              "at end time",
+			fork:              eUpgrade,
+			newChainTime:      subnetVdrEndTime,
+			expectedRemovalAt: subnetVdrEndTime.Add(gracePeriod),
+			expectedRemoved:   false,
+		},
+		{
+			name:              "before grace period ends",
+			fork:              eUpgrade,
+			newChainTime:      subnetVdrEndTime.Add(gracePeriod - time.Second),
+			expectedRemovalAt: subnetVdrEndTime.Add(gracePeriod),
+			expectedRemoved:   false,
+		},
+		{
+			name:              "at grace period end",
+			fork:              eUpgrade,
+			newChainTime:      subnetVdrEndTime.Add(gracePeriod),
+			expectedRemovalAt: subnetVdrEndTime.Add(gracePeriod),
+			expectedRemoved:   true,
+		},
+		{
+			name:              "at end time before the E upgrade",
+			fork:              durango,
+			newChainTime:      subnetVdrEndTime,
+			expectedRemovalAt: subnetVdrEndTime,
+			expectedRemoved:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, test.fork)
+			env.ctx.Lock.Lock()
+			defer env.ctx.Lock.Unlock()
+
+			env.config.SubnetValidatorRemovalGracePeriod = gracePeriod
+
+			subnetID := testSubnet1.ID()
+			staker := addCurrentSubnetValidator(t, env, subnetID, subnetVdrNodeID, subnetVdrEndTime)
+
+			// The removal of the subnet validator is delayed by the grace
+			// period once the E upgrade is activated.
+			nextStakerChangeTime, err := GetNextStakerChangeTime(env.config, env.state)
+			require.NoError(err)
+			require.Equal(test.expectedRemovalAt.Unix(), nextStakerChangeTime.Unix())
+			require.Equal(subnetVdrEndTime.Unix(), staker.EndTime.Unix())
+
+			onCommitState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			changed, err := AdvanceTimeTo(&env.backend, onCommitState, test.newChainTime)
+			require.NoError(err)
+			require.Equal(test.expectedRemoved, changed)
+
+			_, err = onCommitState.GetCurrentValidator(subnetID, subnetVdrNodeID)
+			if test.expectedRemoved {
+				require.ErrorIs(err, database.ErrNotFound)
+			} else {
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+// Ensure that a primary network validator whose end time is inside of a
+// permissioned subnet validator's removal grace period can be rewarded, and
+// that the subnet validator is removed once its grace period ends.
+func TestRewardValidatorInsideSubnetValidatorRemovalGracePeriod(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, eUpgrade)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	const gracePeriod = 10 * time.Second
+	env.config.SubnetValidatorRemovalGracePeriod = gracePeriod
+
+	// The genesis primary network validators end at [defaultValidateEndTime],
+	// which is inside of the subnet validator's grace period.
+	var (
+		subnetID         = testSubnet1.ID()
+		subnetVdrNodeID  = genesisNodeIDs[0]
+		subnetVdrEndTime = defaultValidateEndTime.Add(-gracePeriod / 2)
+		removalTime      = subnetVdrEndTime.Add(gracePeriod)
+	)
+	subnetVdr := addCurrentSubnetValidator(t, env, subnetID, subnetVdrNodeID, subnetVdrEndTime)
+
+	// The subnet validator's end time isn't a staker change time.
+	nextStakerChangeTime, err := GetNextStakerChangeTime(env.config, env.state)
+	require.NoError(err)
+	require.Equal(defaultValidateEndTime.Unix(), nextStakerChangeTime.Unix())
+
+	// Advancing to the subnet validator's end time doesn't remove it.
+	advanceTimeTo(t, env, subnetVdrEndTime, false)
+
+	advanceTimeTo(t, env, defaultValidateEndTime, false)
+
+	// The subnet validator is still the first current staker, but it can't be
+	// rewarded.
+	tx, err := newRewardValidatorTx(t, subnetVdr.TxID)
+	require.NoError(err)
+	err = executeProposalTx(env, tx)
+	require.ErrorIs(err, ErrRemoveWrongStaker)
+
+	// Every genesis primary network validator can be rewarded.
+	for range genesisNodeIDs {
+		currentStakerIterator, err := env.state.GetCurrentStakerIterator()
+		require.NoError(err)
+		require.True(currentStakerIterator.Next())
+		require.Equal(subnetVdr.TxID, currentStakerIterator.Value().TxID)
+		require.True(currentStakerIterator.Next())
+		stakerToReward := currentStakerIterator.Value()
+		currentStakerIterator.Release()
+		require.Equal(defaultValidateEndTime.Unix(), stakerToReward.EndTime.Unix())
+
+		tx, err := newRewardValidatorTx(t, stakerToReward.TxID)
+		require.NoError(err)
+		require.NoError(executeProposalTx(env, tx))
+	}
+
+	_, err = env.state.GetCurrentValidator(constants.PrimaryNetworkID, subnetVdrNodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+	_, err = env.state.GetCurrentValidator(subnetID, subnetVdrNodeID)
+	require.NoError(err)
+
+	// The subnet validator is removed once its grace period ends.
+	nextStakerChangeTime, err = GetNextStakerChangeTime(env.config, env.state)
+	require.NoError(err)
+	require.Equal(removalTime.Unix(), nextStakerChangeTime.Unix())
+
+	advanceTimeTo(t, env, removalTime, true)
+
+	_, err = env.state.GetCurrentValidator(subnetID, subnetVdrNodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+// addCurrentSubnetValidator adds a permissioned validator of [subnetID], that
+// validates from [defaultValidateStartTime] to [endTime], to the current
+// validator set.
+func addCurrentSubnetValidator(
+	t *testing.T,
+	env *environment,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	endTime time.Time,
+) *state.Staker {
+	require := require.New(t)
+
+	tx, err := env.txBuilder.NewAddSubnetValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  uint64(defaultValidateStartTime.Unix()),
+				End:    uint64(endTime.Unix()),
+				Wght:   1,
+			},
+			Subnet: subnetID,
+		},
+		[]*secp256k1.PrivateKey{preFundedKeys[0], preFundedKeys[1]},
+	)
+	require.NoError(err)
+
+	addSubnetValTx := tx.Unsigned.(*txs.AddSubnetValidatorTx)
+	staker, err := state.NewCurrentStaker(
+		tx.ID(),
+		addSubnetValTx,
+		addSubnetValTx.StartTime(),
+		0,
+	)
+	require.NoError(err)
+
+	env.state.PutCurrentValidator(staker)
+	env.state.AddTx(tx, status.Committed)
+	env.state.SetHeight(1)
+	require.NoError(env.state.Commit())
+	return staker
+}
+
+// advanceTimeTo advances the chain time of [env] to [newChainTime] and
+// commits the result.
+func advanceTimeTo(t *testing.T, env *environment, newChainTime time.Time, expectedChanged bool) {
+	require := require.New(t)
+
+	onCommitState, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	changed, err := AdvanceTimeTo(&env.backend, onCommitState, newChainTime)
+	require.NoError(err)
+	require.Equal(expectedChanged, changed)
+
+	require.NoError(onCommitState.Apply(env.state))
+	require.NoError(env.state.Commit())
+}
+
+// executeProposalTx executes [tx] and, if it is valid, commits its on commit
+// state.
+func executeProposalTx(env *environment, tx *txs.Tx) error {
+	onCommitState, err := state.NewDiff(lastAcceptedID, env)
+	if err != nil {
+		return err
+	}
+	onAbortState, err := state.NewDiff(lastAcceptedID, env)
+	if err != nil {
+		return err
+	}
+
+	txExecutor := ProposalTxExecutor{
+		OnCommitState: onCommitState,
+		OnAbortState:  onAbortState,
+		Backend:       &env.backend,
+		Tx:            tx,
+	}
+	if err := tx.Unsigned.Visit(&txExecutor); err != nil {
+		return err
+	}
+	if err := onCommitState.Apply(env.state); err != nil {
+		return err
+	}
+	return env.state.Commit()
+}
+
 func TestTrackedSubnet(t *testing.T) {
 	for _, tracked := range []bool{true, false} {
 		t.Run(fmt.Sprintf("tracked %t", tracked), func(t *testing.T) {
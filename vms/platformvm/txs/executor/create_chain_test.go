@@ -4,6 +4,7 @@
 package executor
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/hashing"
@@ -153,6 +155,102 @@ func TestCreateChainTxValid(t *testing.T) {
 	require.NoError(tx.Unsigned.Visit(&executor))
 }
 
+// Ensure Execute reports which chain tried to be validated by the primary
+// network
+func TestCreateChainTxPrimaryNetwork(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, banff)
+	env.ctx.Lock.Lock()
+	defer env.ctx.Lock.Unlock()
+
+	tx, err := env.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		constants.AVMID,
+		nil,
+		"chain name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+	)
+	require.NoError(err)
+
+	tx.Unsigned.(*txs.CreateChainTx).SubnetID = constants.PrimaryNetworkID
+
+	stateDiff, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   stateDiff,
+		Tx:      tx,
+	}
+	err = tx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, txs.ErrCantValidatePrimaryNetwork)
+	require.EqualError(
+		err,
+		fmt.Sprintf(
+			"invalid chain %s: %s: chain %q was assigned to subnet %s",
+			tx.ID(),
+			txs.ErrCantValidatePrimaryNetwork,
+			"chain name",
+			constants.PrimaryNetworkID,
+		),
+	)
+}
+
+// Ensure Execute fails when the chain requests too many feature extensions,
+// once the E upgrade is activated
+func TestCreateChainTxTooManyFxs(t *testing.T) {
+	tests := []struct {
+		name        string
+		fork        fork
+		expectedErr error
+	}{
+		{
+			name:        "pre E upgrade",
+			fork:        durango,
+			expectedErr: nil,
+		},
+		{
+			name:        "post E upgrade",
+			fork:        eUpgrade,
+			expectedErr: errTooManyFxs,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			env := newEnvironment(t, test.fork)
+			env.config.MaxChainFxs = 1
+			env.ctx.Lock.Lock()
+			defer env.ctx.Lock.Unlock()
+
+			fxIDs := []ids.ID{secp256k1fx.ID, ids.GenerateTestID()}
+			utils.Sort(fxIDs)
+
+			tx, err := env.txBuilder.NewCreateChainTx(
+				testSubnet1.ID(),
+				nil,
+				constants.AVMID,
+				fxIDs,
+				"chain name",
+				[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+			)
+			require.NoError(err)
+
+			stateDiff, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := StandardTxExecutor{
+				Backend: &env.backend,
+				State:   stateDiff,
+				Tx:      tx,
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
 func TestCreateChainTxAP3FeeChange(t *testing.T) {
 	ap3Time := defaultGenesisTime.Add(time.Hour)
 	tests := []struct {
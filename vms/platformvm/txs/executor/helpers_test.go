@@ -290,6 +290,8 @@ func defaultConfig(t *testing.T, f fork) *config.Config {
 		MinDelegatorStake: 1 * units.MilliAvax,
 		MinStakeDuration:  defaultMinStakingDuration,
 		MaxStakeDuration:  defaultMaxStakingDuration,
+		MaxChainFxs:       txs.DefaultMaxFxIDs,
+		MaxTxInputs:       txs.DefaultMaxInputs,
 		RewardConfig: reward.Config{
 			MaxConsumptionRate: .12 * reward.PercentDenominator,
 			MinConsumptionRate: .10 * reward.PercentDenominator,
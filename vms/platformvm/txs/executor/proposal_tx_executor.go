@@ -269,7 +269,7 @@ func (e *ProposalTxExecutor) AdvanceTimeTx(tx *txs.AdvanceTimeTx) error {
 
 	// Only allow timestamp to move forward as far as the time of next staker
 	// set change time
-	nextStakerChangeTime, err := GetNextStakerChangeTime(e.OnCommitState)
+	nextStakerChangeTime, err := GetNextStakerChangeTime(e.Config, e.OnCommitState)
 	if err != nil {
 		return err
 	}
@@ -298,15 +298,29 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		return errWrongNumberOfCredentials
 	}
 
+	currentChainTime := e.OnCommitState.GetTimestamp()
+	gracePeriod := e.Config.RemovalGracePeriod(currentChainTime)
+
 	currentStakerIterator, err := e.OnCommitState.GetCurrentStakerIterator()
 	if err != nil {
 		return err
 	}
-	if !currentStakerIterator.Next() {
-		return fmt.Errorf("failed to get next staker to remove: %w", database.ErrNotFound)
+	var stakerToReward *state.Staker
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		// Permissioned subnet validators inside of their removal grace period
+		// may precede the staker to reward. They are removed by the
+		// advancement of time once their grace period ends.
+		if gracePeriod > 0 && staker.Priority == txs.SubnetPermissionedValidatorCurrentPriority {
+			continue
+		}
+		stakerToReward = staker
+		break
 	}
-	stakerToReward := currentStakerIterator.Value()
 	currentStakerIterator.Release()
+	if stakerToReward == nil {
+		return fmt.Errorf("failed to get next staker to remove: %w", database.ErrNotFound)
+	}
 
 	if stakerToReward.TxID != tx.TxID {
 		return fmt.Errorf(
@@ -318,7 +332,6 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 	}
 
 	// Verify that the chain's timestamp is the validator's end time
-	currentChainTime := e.OnCommitState.GetTimestamp()
 	if !stakerToReward.EndTime.Equal(currentChainTime) {
 		return fmt.Errorf(
 			"%w: TxID = %s with %s < %s",
@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
@@ -96,7 +97,12 @@ func getDelegatorRules(
 
 // GetNextStakerChangeTime returns the next time a staker will be either added
 // or removed to/from the current validator set.
-func GetNextStakerChangeTime(state state.Chain) (time.Time, error) {
+//
+// Permissioned subnet validators are removed the removal grace period after
+// their [EndTime].
+func GetNextStakerChangeTime(cfg *config.Config, state state.Chain) (time.Time, error) {
+	gracePeriod := cfg.RemovalGracePeriod(state.GetTimestamp())
+
 	currentStakerIterator, err := state.GetCurrentStakerIterator()
 	if err != nil {
 		return time.Time{}, err
@@ -109,23 +115,41 @@ func GetNextStakerChangeTime(state state.Chain) (time.Time, error) {
 	}
 	defer pendingStakerIterator.Release()
 
-	hasCurrentStaker := currentStakerIterator.Next()
-	hasPendingStaker := pendingStakerIterator.Next()
-	switch {
-	case hasCurrentStaker && hasPendingStaker:
-		nextCurrentTime := currentStakerIterator.Value().NextTime
+	var (
+		nextTime    time.Time
+		hasNextTime bool
+	)
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		// Stakers are ordered by [NextTime], and the removal time of a staker
+		// is never before its [NextTime], so no later staker can change the
+		// result.
+		if hasNextTime && !staker.NextTime.Before(nextTime) {
+			break
+		}
+
+		stakerTime := staker.NextTime
+		if staker.Priority == txs.SubnetPermissionedValidatorCurrentPriority {
+			stakerTime = stakerTime.Add(gracePeriod)
+		}
+		if !hasNextTime || stakerTime.Before(nextTime) {
+			nextTime = stakerTime
+			hasNextTime = true
+		}
+	}
+
+	if pendingStakerIterator.Next() {
 		nextPendingTime := pendingStakerIterator.Value().NextTime
-		if nextCurrentTime.Before(nextPendingTime) {
-			return nextCurrentTime, nil
+		if !hasNextTime || nextPendingTime.Before(nextTime) {
+			nextTime = nextPendingTime
+			hasNextTime = true
 		}
-		return nextPendingTime, nil
-	case hasCurrentStaker:
-		return currentStakerIterator.Value().NextTime, nil
-	case hasPendingStaker:
-		return pendingStakerIterator.Value().NextTime, nil
-	default:
+	}
+
+	if !hasNextTime {
 		return time.Time{}, database.ErrNotFound
 	}
+	return nextTime, nil
 }
 
 // GetValidator returns information about the given validator, which may be a
@@ -28,6 +28,7 @@ var (
 	errEmptyNodeID                = errors.New("validator nodeID cannot be empty")
 	errMaxStakeDurationTooLarge   = errors.New("max stake duration must be less than or equal to the global max stake duration")
 	errMissingStartTimePreDurango = errors.New("staker transactions must have a StartTime pre-Durango")
+	errTooManyFxs                 = errors.New("too many feature extensions")
 )
 
 type StandardTxExecutor struct {
@@ -52,16 +53,20 @@ func (*StandardTxExecutor) RewardValidatorTx(*txs.RewardValidatorTx) error {
 
 func (e *StandardTxExecutor) CreateChainTx(tx *txs.CreateChainTx) error {
 	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
-		return err
+		return fmt.Errorf("invalid chain %s: %w", e.Tx.ID(), err)
 	}
 
 	var (
 		currentTimestamp = e.State.GetTimestamp()
 		isDurangoActive  = e.Config.UpgradeConfig.IsDurangoActivated(currentTimestamp)
+		isEActive        = e.Config.UpgradeConfig.IsEActivated(currentTimestamp)
 	)
 	if err := avax.VerifyMemoFieldLength(tx.Memo, isDurangoActive); err != nil {
 		return err
 	}
+	if numFxs := len(tx.FxIDs); isEActive && numFxs > e.Config.MaxChainFxs {
+		return fmt.Errorf("%w: %d > %d", errTooManyFxs, numFxs, e.Config.MaxChainFxs)
+	}
 
 	baseTxCreds, err := verifyPoASubnetAuthorization(e.Backend, e.State, e.Tx, tx.SubnetID, tx.SubnetAuth)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -58,7 +59,7 @@ func VerifyNewChainTime(
 	return nil
 }
 
-func NextBlockTime(state state.Chain, clk *mockable.Clock) (time.Time, bool, error) {
+func NextBlockTime(cfg *config.Config, state state.Chain, clk *mockable.Clock) (time.Time, bool, error) {
 	var (
 		timestamp  = clk.Time()
 		parentTime = state.GetTimestamp()
@@ -68,7 +69,7 @@ func NextBlockTime(state state.Chain, clk *mockable.Clock) (time.Time, bool, err
 	}
 	// [timestamp] = max(now, parentTime)
 
-	nextStakerChangeTime, err := GetNextStakerChangeTime(state)
+	nextStakerChangeTime, err := GetNextStakerChangeTime(cfg, state)
 	if err != nil {
 		return time.Time{}, false, fmt.Errorf("failed getting next staker change time: %w", err)
 	}
@@ -165,7 +166,13 @@ func AdvanceTimeTo(
 		changed = true
 	}
 
-	// Remove any current stakers whose [EndTime] <= [newChainTime].
+	// Remove any current stakers whose [EndTime] <= [newChainTime]. Permissioned
+	// subnet validators are only removed once their [EndTime] plus the removal
+	// grace period is <= [newChainTime].
+	//
+	// The grace period is determined by the parent's timestamp, as it is by
+	// [GetNextStakerChangeTime].
+	gracePeriod := backend.Config.RemovalGracePeriod(parentState.GetTimestamp())
 	currentStakerIterator, err := parentState.GetCurrentStakerIterator()
 	if err != nil {
 		return false, err
@@ -186,6 +193,12 @@ func AdvanceTimeTo(
 			break
 		}
 
+		// Any later permissioned staker has an [EndTime] that is at least as
+		// late, so it is also still inside of its grace period.
+		if stakerToRemove.EndTime.Add(gracePeriod).After(newChainTime) {
+			break
+		}
+
 		changes.DeleteCurrentValidator(stakerToRemove)
 		changed = true
 	}
@@ -5,6 +5,7 @@ package mempool
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -19,6 +20,7 @@ var (
 
 	ErrCantIssueAdvanceTimeTx     = errors.New("can not issue an advance time tx")
 	ErrCantIssueRewardValidatorTx = errors.New("can not issue a reward validator tx")
+	ErrTooManyInputs              = errors.New("too many inputs")
 )
 
 type Mempool interface {
@@ -35,13 +37,17 @@ type Mempool interface {
 type mempool struct {
 	txmempool.Mempool[*txs.Tx]
 
-	toEngine chan<- common.Message
+	toEngine  chan<- common.Message
+	maxInputs int
 }
 
+// New returns a mempool that rejects txs consuming more than [maxInputs]
+// inputs, including imported inputs.
 func New(
 	namespace string,
 	registerer prometheus.Registerer,
 	toEngine chan<- common.Message,
+	maxInputs int,
 ) (Mempool, error) {
 	metrics, err := txmempool.NewMetrics(namespace, registerer)
 	if err != nil {
@@ -51,8 +57,9 @@ func New(
 		metrics,
 	)
 	return &mempool{
-		Mempool:  pool,
-		toEngine: toEngine,
+		Mempool:   pool,
+		toEngine:  toEngine,
+		maxInputs: maxInputs,
 	}, nil
 }
 
@@ -65,6 +72,12 @@ func (m *mempool) Add(tx *txs.Tx) error {
 	default:
 	}
 
+	// The limit only bounds the txs this node issues and gossips. Txs with
+	// more inputs are still valid when included in a block.
+	if numInputs := tx.Unsigned.InputIDs().Len(); numInputs > m.maxInputs {
+		return fmt.Errorf("%w: %d > %d", ErrTooManyInputs, numInputs, m.maxInputs)
+	}
+
 	return m.Mempool.Add(tx)
 }
 
@@ -0,0 +1,69 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package mempool
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func TestAddTooManyInputs(t *testing.T) {
+	const maxInputs = 2
+
+	tests := []struct {
+		name        string
+		numInputs   int
+		expectedErr error
+	}{
+		{
+			name:        "at limit",
+			numInputs:   maxInputs,
+			expectedErr: nil,
+		},
+		{
+			name:        "over limit",
+			numInputs:   maxInputs + 1,
+			expectedErr: ErrTooManyInputs,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			mempool, err := New("mempool", prometheus.NewRegistry(), nil, maxInputs)
+			require.NoError(err)
+
+			tx := newTx(test.numInputs)
+			err = mempool.Add(tx)
+			require.ErrorIs(err, test.expectedErr)
+
+			_, ok := mempool.Get(tx.ID())
+			require.Equal(test.expectedErr == nil, ok)
+		})
+	}
+}
+
+func newTx(numInputs int) *txs.Tx {
+	ins := make([]*avax.TransferableInput, numInputs)
+	for i := range ins {
+		ins[i] = &avax.TransferableInput{
+			UTXOID: avax.UTXOID{
+				TxID:        ids.ID{'t', 'x', 'I', 'D'},
+				OutputIndex: uint32(i),
+			},
+		}
+	}
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		Ins: ins,
+	}}}
+	tx.SetBytes(utils.RandomBytes(32), utils.RandomBytes(32))
+	return tx
+}
@@ -35,5 +35,6 @@ func newContext(
 		AddPrimaryNetworkDelegatorFee: cfg.StaticFeeConfig.AddPrimaryNetworkDelegatorFee,
 		AddSubnetValidatorFee:         cfg.StaticFeeConfig.AddSubnetValidatorFee,
 		AddSubnetDelegatorFee:         cfg.StaticFeeConfig.AddSubnetDelegatorFee,
+		MaxInputs:                     cfg.MaxTxInputs,
 	}
 }
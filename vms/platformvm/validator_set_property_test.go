@@ -664,6 +664,7 @@ func buildVM(t *testing.T) (*VM, ids.ID, error) {
 		MinDelegatorStake: defaultMinDelegatorStake,
 		MinStakeDuration:  defaultMinStakingDuration,
 		MaxStakeDuration:  defaultMaxStakingDuration,
+		MaxTxInputs:       txs.DefaultMaxInputs,
 		RewardConfig:      defaultRewardConfig,
 		UpgradeConfig: upgrade.Config{
 			ApricotPhase3Time: forkTime,
@@ -16,6 +16,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/cache"
+	"github.com/ava-labs/avalanchego/cache/metercacher"
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
 	"github.com/ava-labs/avalanchego/database"
@@ -33,6 +34,7 @@ import (
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blockstream"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
@@ -57,6 +59,8 @@ var (
 	_ secp256k1fx.VM             = (*VM)(nil)
 	_ validators.State           = (*VM)(nil)
 	_ validators.SubnetConnector = (*VM)(nil)
+
+	errSupplyCapBelowCurrentSupply = errors.New("supply cap is below the current supply")
 )
 
 type VM struct {
@@ -67,6 +71,13 @@ type VM struct {
 
 	metrics metrics.Metrics
 
+	// stakerAttributesCache is shared by the API services to avoid re-parsing
+	// staker txs.
+	stakerAttributesCache cache.Cacher[ids.ID, *stakerAttributes]
+
+	// blockStream pushes accepted blocks to websocket subscribers.
+	blockStream *blockstream.Server
+
 	// Used to get time. Useful for faking time during tests.
 	clock mockable.Clock
 
@@ -86,6 +97,10 @@ type VM struct {
 
 	manager blockexecutor.Manager
 
+	// blockStalenessWindow is the maximum amount of time this node may go
+	// without accepting a block while it is expected to be producing blocks.
+	blockStalenessWindow time.Duration
+
 	// Cancelled on shutdown
 	onShutdownCtx context.Context
 	// Call [onShutdownCtxCancel] to cancel [onShutdownCtx] during Shutdown()
@@ -124,12 +139,25 @@ func (vm *VM) Initialize(
 		return fmt.Errorf("failed to initialize metrics: %w", err)
 	}
 
+	vm.stakerAttributesCache, err = metercacher.New[ids.ID, *stakerAttributes](
+		"staker_attributes_cache",
+		registerer,
+		&cache.LRU[ids.ID, *stakerAttributes]{Size: stakerAttributesCacheSize},
+	)
+	if err != nil {
+		return err
+	}
+
 	vm.ctx = chainCtx
 	vm.db = db
+	vm.blockStalenessWindow = execConfig.BlockStalenessWindow
 
 	// Note: this codec is never used to serialize anything
 	vm.codecRegistry = linearcodec.NewDefault()
-	vm.fx = &secp256k1fx.Fx{}
+	vm.fx = vm.Config.Fx
+	if vm.fx == nil {
+		vm.fx = &secp256k1fx.Fx{}
+	}
 	if err := vm.fx.Initialize(vm); err != nil {
 		return err
 	}
@@ -150,6 +178,16 @@ func (vm *VM) Initialize(
 		return err
 	}
 
+	// The reward calculator assumes that the current supply never exceeds the
+	// supply cap. Once the cap is reached, rewards taper to zero.
+	currentSupply, err := vm.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return err
+	}
+	if currentSupply > vm.RewardConfig.SupplyCap {
+		return fmt.Errorf("%w: %d < %d", errSupplyCapBelowCurrentSupply, vm.RewardConfig.SupplyCap, currentSupply)
+	}
+
 	validatorManager := pvalidators.NewManager(chainCtx.Log, vm.Config, vm.state, vm.metrics, &vm.clock)
 	vm.State = validatorManager
 	utxoVerifier := utxo.NewVerifier(vm.ctx, &vm.clock, vm.fx)
@@ -167,17 +205,19 @@ func (vm *VM) Initialize(
 		Bootstrapped: &vm.bootstrapped,
 	}
 
-	mempool, err := pmempool.New("mempool", registerer, toEngine)
+	mempool, err := pmempool.New("mempool", registerer, toEngine, vm.MaxTxInputs)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
 
+	vm.blockStream = blockstream.New(vm.ctx.Log)
 	vm.manager = blockexecutor.NewManager(
 		mempool,
 		vm.metrics,
 		vm.state,
 		txExecutorBackend,
 		validatorManager,
+		vm.blockStream,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&txExecutorBackend.Ctx.Lock, vm.manager)
@@ -210,6 +250,8 @@ func (vm *VM) Initialize(
 		mempool,
 		txExecutorBackend,
 		vm.manager,
+		vm.metrics,
+		execConfig.MaxBlockSize,
 	)
 
 	// Create all of the chains that the database says exist
@@ -395,6 +437,10 @@ func (vm *VM) Shutdown(context.Context) error {
 	vm.onShutdownCtxCancel()
 	vm.Builder.ShutdownBlockTimer()
 
+	if vm.blockStream != nil {
+		vm.blockStream.Close()
+	}
+
 	if vm.bootstrapped.Get() {
 		primaryVdrIDs := vm.Validators.GetValidatorIDs(constants.PrimaryNetworkID)
 		if err := vm.uptimeManager.StopTracking(primaryVdrIDs, constants.PrimaryNetworkID); err != nil {
@@ -460,16 +506,48 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]http.Handler, error) {
 	server.RegisterInterceptFunc(vm.metrics.InterceptRequest)
 	server.RegisterAfterFunc(vm.metrics.AfterRequest)
 	service := &Service{
-		vm:          vm,
-		addrManager: avax.NewAddressManager(vm.ctx),
-		stakerAttributesCache: &cache.LRU[ids.ID, *stakerAttributes]{
-			Size: stakerAttributesCacheSize,
-		},
-	}
-	err := server.RegisterService(service, "platform")
-	return map[string]http.Handler{
-		"": server,
-	}, err
+		vm:                    vm,
+		addrManager:           avax.NewAddressManager(vm.ctx),
+		stakerAttributesCache: vm.stakerAttributesCache,
+	}
+	if err := server.RegisterService(service, "platform"); err != nil {
+		return nil, err
+	}
+
+	handlers := map[string]http.Handler{
+		"":        server,
+		"/blocks": vm.blockStream,
+	}
+	if !vm.AdminAPIEnabled {
+		return handlers, nil
+	}
+
+	adminServer := rpc.NewServer()
+	adminServer.RegisterCodec(json.NewCodec(), "application/json")
+	adminServer.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+	adminServer.RegisterInterceptFunc(vm.metrics.InterceptRequest)
+	adminServer.RegisterAfterFunc(vm.metrics.AfterRequest)
+	if err := adminServer.RegisterService(&AdminService{vm: vm}, "platform"); err != nil {
+		return nil, err
+	}
+	handlers["/admin"] = adminServer
+	return handlers, nil
+}
+
+// RebuildValidators clears the node's validator sets and repopulates them
+// from the current stakers in the P-chain state.
+func (vm *VM) RebuildValidators(context.Context) error {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	if err := vm.state.RebuildValidatorSets(); err != nil {
+		return fmt.Errorf("failed to rebuild validator sets: %w", err)
+	}
+
+	vm.ctx.Log.Info("rebuilt validator sets",
+		zap.Int("numPrimaryValidators", vm.Validators.Count(constants.PrimaryNetworkID)),
+	)
+	return nil
 }
 
 func (vm *VM) Connected(ctx context.Context, nodeID ids.NodeID, version *version.Application) error {
@@ -442,6 +442,7 @@ func TestUnverifiedParentPanicRegression(t *testing.T) {
 		UptimeLockedCalculator: uptime.NewLockedCalculator(),
 		MinStakeDuration:       defaultMinStakingDuration,
 		MaxStakeDuration:       defaultMaxStakingDuration,
+		MaxTxInputs:            txs.DefaultMaxInputs,
 		RewardConfig:           defaultRewardConfig,
 		UpgradeConfig: upgrade.Config{
 			BanffTime:    latestForkTime,
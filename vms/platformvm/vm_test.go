@@ -6,9 +6,12 @@ package platformvm
 import (
 	"bytes"
 	"context"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
@@ -52,6 +55,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blockstream"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
@@ -70,6 +74,7 @@ import (
 	blockbuilder "github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
 	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	proposervmblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
 	walletbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
 	walletcommon "github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 )
@@ -257,6 +262,8 @@ func defaultVM(t *testing.T, f fork) (*VM, *txstest.Builder, database.Database,
 		MinDelegatorStake: defaultMinDelegatorStake,
 		MinStakeDuration:  defaultMinStakingDuration,
 		MaxStakeDuration:  defaultMaxStakingDuration,
+		MaxChainFxs:       txs.DefaultMaxFxIDs,
+		MaxTxInputs:       txs.DefaultMaxInputs,
 		RewardConfig:      defaultRewardConfig,
 		UpgradeConfig: upgrade.Config{
 			ApricotPhase3Time: apricotPhase3Time,
@@ -1425,7 +1432,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 	chainRouter := &router.ChainRouter{}
 
 	metrics := prometheus.NewRegistry()
-	mc, err := message.NewCreator(logging.NoLog{}, metrics, "dummyNamespace", constants.DefaultNetworkCompressionType, 10*time.Second)
+	mc, err := message.NewCreator(logging.NoLog{}, metrics, "dummyNamespace", constants.DefaultNetworkCompressionType, constants.DefaultNetworkCompressionThreshold, 10*time.Second)
 	require.NoError(err)
 
 	require.NoError(chainRouter.Initialize(
@@ -1471,7 +1478,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 	peers := tracker.NewPeers()
 	totalWeight, err := beacons.TotalWeight(ctx.SubnetID)
 	require.NoError(err)
-	startup := tracker.NewStartup(peers, (totalWeight+1)/2)
+	startup := tracker.NewStartup(peers, (totalWeight+1)/2, 0)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startup)
 
 	// The engine handles consensus
@@ -1777,6 +1784,44 @@ func TestUnverifiedParent(t *testing.T) {
 	require.NoError(secondAdvanceTimeBlk.Verify(context.Background()))
 }
 
+func TestInitializeSupplyCapBelowCurrentSupply(t *testing.T) {
+	require := require.New(t)
+
+	rewardConfig := defaultRewardConfig
+	rewardConfig.SupplyCap = 1
+	vm := &VM{Config: config.Config{
+		Chains:                 chains.TestManager,
+		Validators:             validators.NewManager(),
+		UptimeLockedCalculator: uptime.NewLockedCalculator(),
+		RewardConfig:           rewardConfig,
+		UpgradeConfig: upgrade.Config{
+			BanffTime:    latestForkTime,
+			CortinaTime:  latestForkTime,
+			DurangoTime:  latestForkTime,
+			EUpgradeTime: mockable.MaxTime,
+		},
+	}}
+
+	ctx := snowtest.Context(t, snowtest.PChainID)
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	_, genesisBytes := defaultGenesis(t, ctx.AVAXAssetID)
+
+	err := vm.Initialize(
+		context.Background(),
+		ctx,
+		memdb.New(),
+		genesisBytes,
+		nil,
+		nil,
+		make(chan common.Message, 1),
+		nil,
+		nil,
+	)
+	require.ErrorIs(err, errSupplyCapBelowCurrentSupply)
+}
+
 func TestMaxStakeAmount(t *testing.T) {
 	vm, _, _, _ := defaultVM(t, latestFork)
 	vm.ctx.Lock.Lock()
@@ -1886,6 +1931,7 @@ func TestUptimeDisallowedWithRestart(t *testing.T) {
 	secondVM := &VM{Config: config.Config{
 		Chains:                 chains.TestManager,
 		UptimePercentage:       secondUptimePercentage / 100.,
+		RewardConfig:           defaultRewardConfig,
 		Validators:             validators.NewManager(),
 		UptimeLockedCalculator: uptime.NewLockedCalculator(),
 		UpgradeConfig: upgrade.Config{
@@ -2489,3 +2535,137 @@ func TestPruneMempool(t *testing.T) {
 	_, ok = vm.Builder.Get(baseTxID)
 	require.True(ok)
 }
+
+func TestRebuildValidators(t *testing.T) {
+	require := require.New(t)
+	vm, _, _, _ := defaultVM(t, latestFork)
+
+	expectedPrimaryValidators := vm.Validators.GetMap(constants.PrimaryNetworkID)
+	expectedSubnetValidators := vm.Validators.GetMap(testSubnet1.ID())
+
+	// Corrupt the validator sets
+	vm.ctx.Lock.Lock()
+	unknownNodeID := ids.GenerateTestNodeID()
+	require.NoError(vm.Validators.AddStaker(constants.PrimaryNetworkID, unknownNodeID, nil, ids.Empty, 1))
+	require.NoError(vm.Validators.AddStaker(testSubnet1.ID(), unknownNodeID, nil, ids.Empty, 1))
+	require.NoError(vm.Validators.RemoveWeight(constants.PrimaryNetworkID, genesisNodeIDs[0], 1))
+	vm.ctx.Lock.Unlock()
+
+	require.NoError(vm.RebuildValidators(context.Background()))
+
+	require.Equal(expectedPrimaryValidators, vm.Validators.GetMap(constants.PrimaryNetworkID))
+	require.Equal(expectedSubnetValidators, vm.Validators.GetMap(testSubnet1.ID()))
+}
+
+type initializeRecordingFx struct {
+	secp256k1fx.Fx
+
+	initialized bool
+}
+
+func (fx *initializeRecordingFx) Initialize(vm interface{}) error {
+	fx.initialized = true
+	return fx.Fx.Initialize(vm)
+}
+
+func TestConfiguredFx(t *testing.T) {
+	require := require.New(t)
+
+	fx := &initializeRecordingFx{}
+	vm := &VM{Config: config.Config{
+		Chains:                 chains.TestManager,
+		Fx:                     fx,
+		Validators:             validators.NewManager(),
+		UptimeLockedCalculator: uptime.NewLockedCalculator(),
+		MinStakeDuration:       defaultMinStakingDuration,
+		MaxStakeDuration:       defaultMaxStakingDuration,
+		RewardConfig:           defaultRewardConfig,
+		UpgradeConfig: upgrade.Config{
+			BanffTime:    latestForkTime,
+			CortinaTime:  latestForkTime,
+			DurangoTime:  latestForkTime,
+			EUpgradeTime: mockable.MaxTime,
+		},
+	}}
+
+	ctx := snowtest.Context(t, snowtest.PChainID)
+	ctx.Lock.Lock()
+	defer func() {
+		require.NoError(vm.Shutdown(context.Background()))
+		ctx.Lock.Unlock()
+	}()
+
+	_, genesisBytes := defaultGenesis(t, ctx.AVAXAssetID)
+
+	msgChan := make(chan common.Message, 1)
+	require.NoError(vm.Initialize(
+		context.Background(),
+		ctx,
+		memdb.New(),
+		genesisBytes,
+		nil,
+		nil,
+		msgChan,
+		nil,
+		nil,
+	))
+
+	require.True(fx.initialized)
+	require.Equal(fx, vm.fx)
+}
+
+func TestBlockStreamUnwrapsProposerVMBlocks(t *testing.T) {
+	require := require.New(t)
+	vm, txBuilder, _, _ := defaultVM(t, latestFork)
+
+	httpServer := httptest.NewServer(vm.blockStream)
+	defer httpServer.Close()
+
+	url := "ws" + strings.TrimPrefix(httpServer.URL, "http")
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(err)
+	defer conn.Close()
+
+	require.Eventually(func() bool {
+		return vm.blockStream.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	tx, err := txBuilder.NewCreateSubnetTx(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{keys[0].PublicKey().Address()},
+		},
+		[]*secp256k1.PrivateKey{keys[0]},
+	)
+	require.NoError(err)
+	require.NoError(vm.issueTxFromRPC(tx))
+
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	innerBlk, err := vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+
+	// Post-fork, consensus only ever sees the proposervm's wrapper, which the
+	// P-chain can't parse. The proposervm hands the inner bytes to the VM.
+	wrappedBlk, err := proposervmblock.BuildUnsigned(
+		ids.GenerateTestID(),
+		innerBlk.Timestamp(),
+		innerBlk.Height(),
+		innerBlk.Bytes(),
+	)
+	require.NoError(err)
+	_, err = block.Parse(block.Codec, wrappedBlk.Bytes())
+	require.Error(err) //nolint:forbidigo // the codec error isn't exported
+
+	blk, err := vm.ParseBlock(context.Background(), wrappedBlk.Block())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	summary := &blockstream.BlockSummary{}
+	require.NoError(conn.ReadJSON(summary))
+	require.Equal(innerBlk.ID(), summary.ID)
+	require.Equal(innerBlk.Height(), uint64(summary.Height))
+	require.Equal([]ids.ID{tx.ID()}, summary.TxIDs)
+}
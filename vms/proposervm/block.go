@@ -190,6 +190,10 @@ func (p *postForkCommonComponents) buildChild(
 	// The child's P-Chain height is proposed as the optimal P-Chain height that
 	// is at least the parent's P-Chain height
 	pChainHeight, err := p.vm.optimalPChainHeight(ctx, parentPChainHeight)
+	if errors.Is(err, errPChainHeightUnavailable) {
+		p.vm.skipBuildBlock(parentID, err)
+		return nil, err
+	}
 	if err != nil {
 		p.vm.ctx.Log.Error("unexpected build block failure",
 			zap.String("reason", "failed to calculate optimal P-chain height"),
@@ -221,14 +225,9 @@ func (p *postForkCommonComponents) buildChild(
 		return nil, err
 	}
 
-	var innerBlock snowman.Block
-	if p.vm.blockBuilderVM != nil {
-		innerBlock, err = p.vm.blockBuilderVM.BuildBlockWithContext(ctx, &smblock.Context{
-			PChainHeight: parentPChainHeight,
-		})
-	} else {
-		innerBlock, err = p.vm.ChainVM.BuildBlock(ctx)
-	}
+	innerBlock, err := p.vm.buildInnerBlock(ctx, &smblock.Context{
+		PChainHeight: parentPChainHeight,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -280,6 +279,7 @@ func (p *postForkCommonComponents) buildChild(
 		zap.Time("parentTimestamp", parentTimestamp),
 		zap.Time("blockTimestamp", newTimestamp),
 	)
+	p.vm.metrics.blocksBuilt.WithLabelValues(postForkBlockType).Inc()
 	return child, nil
 }
 
@@ -468,7 +468,7 @@ func (p *postForkCommonComponents) shouldBuildSignedBlockPreDurango(
 	newTimestamp time.Time,
 ) (bool, error) {
 	delay := newTimestamp.Sub(parentTimestamp)
-	if delay >= proposer.MaxBuildDelay {
+	if delay >= p.vm.freeForAllDelay() {
 		return false, nil // time for any node to build an unsigned block
 	}
 
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -68,6 +69,8 @@ func TestPostForkCommonComponents_buildChild(t *testing.T) {
 
 	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	require.NoError(err)
+	metrics, err := newVMMetrics(prometheus.NewRegistry())
+	require.NoError(err)
 	vm := &VM{
 		Config: Config{
 			ActivationTime:    time.Unix(0, 0),
@@ -83,6 +86,7 @@ func TestPostForkCommonComponents_buildChild(t *testing.T) {
 			Log:            logging.NoLog{},
 		},
 		Windower: windower,
+		metrics:  metrics,
 	}
 
 	blk := &postForkCommonComponents{
@@ -350,6 +354,107 @@ func TestPreDurangoNonValidatorNodeBlockBuiltDelaysTests(t *testing.T) {
 	}
 }
 
+func TestPreDurangoNonValidatorNodeBuildsAfterFreeForAllDelay(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var (
+		activationTime = time.Unix(0, 0)
+		durangoTime    = mockable.MaxTime
+	)
+	coreVM, valState, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+	proVM.FreeForAllDelay = proposer.MaxVerifyDelay
+	defer func() {
+		require.NoError(proVM.Shutdown(ctx))
+	}()
+
+	// Build a post fork block. It'll be the parent block in our test cases
+	parentTime := time.Now().Truncate(time.Second)
+	proVM.Set(parentTime)
+
+	coreParentBlk := snowmantest.BuildChild(snowmantest.Genesis)
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return coreParentBlk, nil
+	}
+	coreVM.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case coreParentBlk.ID():
+			return coreParentBlk, nil
+		case snowmantest.GenesisID:
+			return snowmantest.Genesis, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	coreVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) { // needed when setting preference
+		switch {
+		case bytes.Equal(b, coreParentBlk.Bytes()):
+			return coreParentBlk, nil
+		case bytes.Equal(b, snowmantest.GenesisBytes):
+			return snowmantest.Genesis, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+
+	parentBlk, err := proVM.BuildBlock(ctx)
+	require.NoError(err)
+	require.NoError(parentBlk.Verify(ctx))
+	require.NoError(parentBlk.Accept(ctx))
+
+	// Make sure preference is duly set
+	require.NoError(proVM.SetPreference(ctx, parentBlk.ID()))
+	require.Equal(proVM.preferred, parentBlk.ID())
+	_, err = proVM.getPostForkBlock(ctx, parentBlk.ID())
+	require.NoError(err)
+
+	// Mark node as non validator
+	valState.GetValidatorSetF = func(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+		var (
+			aValidator = ids.GenerateTestNodeID()
+
+			// a validator with a weight large enough to fully fill the proposers list
+			weight = uint64(proposer.MaxBuildWindows * 2)
+		)
+		return map[ids.NodeID]*validators.GetValidatorOutput{
+			aValidator: {
+				NodeID: aValidator,
+				Weight: weight,
+			},
+		}, nil
+	}
+
+	coreChildBlk := snowmantest.BuildChild(coreParentBlk)
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return coreChildBlk, nil
+	}
+
+	{
+		// Set local clock before the free-for-all delay from parent timestamp.
+		// Check that child block is not built.
+		localTime := parentBlk.Timestamp().Add(proposer.MaxVerifyDelay - time.Second)
+		proVM.Set(localTime)
+
+		_, err := proVM.BuildBlock(ctx)
+		require.ErrorIs(err, errProposerWindowNotStarted)
+	}
+
+	{
+		// Set local clock exactly the free-for-all delay from parent timestamp.
+		// Check that child block is built and it is unsigned
+		localTime := parentBlk.Timestamp().Add(proposer.MaxVerifyDelay)
+		proVM.Set(localTime)
+
+		childBlkIntf, err := proVM.BuildBlock(ctx)
+		require.NoError(err)
+		require.IsType(&postForkBlock{}, childBlkIntf)
+
+		childBlk := childBlkIntf.(*postForkBlock)
+		require.Equal(ids.EmptyNodeID, childBlk.Proposer()) // unsigned block
+		require.NoError(childBlk.Verify(ctx))
+	}
+}
+
 // We consider cases where this node is not current proposer (may be scheduled in the next future or not).
 // We check that scheduler is called nonetheless, to be able to process innerVM block requests
 func TestPostDurangoBuildChildResetScheduler(t *testing.T) {
@@ -380,6 +485,8 @@ func TestPostDurangoBuildChildResetScheduler(t *testing.T) {
 
 	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	require.NoError(err)
+	metrics, err := newVMMetrics(prometheus.NewRegistry())
+	require.NoError(err)
 	vm := &VM{
 		Config: Config{
 			ActivationTime:    time.Unix(0, 0),
@@ -395,6 +502,7 @@ func TestPostDurangoBuildChildResetScheduler(t *testing.T) {
 		},
 		Windower:  windower,
 		Scheduler: scheduler,
+		metrics:   metrics,
 	}
 	vm.Clock.Set(now)
 
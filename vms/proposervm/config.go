@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
 )
 
 type Config struct {
@@ -23,10 +24,42 @@ type Config struct {
 	// Configurable minimal delay among blocks issued consecutively
 	MinBlkDelay time.Duration
 
+	// Number of additional attempts made to build an inner block after the
+	// inner VM failed with an error wrapping block.ErrTransientBuildFailure.
+	BuildBlockRetries int
+
+	// Initial delay between attempts to build an inner block. The delay doubles
+	// after every failed attempt.
+	BuildBlockRetryBackoff time.Duration
+
+	// Pre-Durango, delay after the parent block's timestamp at which this node
+	// builds an unsigned block if none of the designated proposers has built
+	// a block. Any validator is allowed to propose such a block once
+	// [proposer.MaxVerifyDelay] has elapsed. The delay is clamped to
+	// [[proposer.MaxVerifyDelay], [proposer.MaxBuildDelay]]. Zero signals
+	// [proposer.MaxBuildDelay].
+	//
+	// Post-Durango, the designated proposer changes every
+	// [proposer.WindowDuration], so an offline proposer only stalls block
+	// production for a single window.
+	FreeForAllDelay time.Duration
+
+	// Upper bound of the node-specific delay added to the scheduled block
+	// building time pre-Durango. Zero disables the jitter.
+	BuildJitter time.Duration
+
 	// Maximal number of block indexed.
 	// Zero signals all blocks are indexed.
 	NumHistoricalBlocks uint64
 
+	// If true, the proposervm debug API is served next to the inner VM's API.
+	// The debug API reveals the proposer schedule of the chain.
+	DebugAPIEnabled bool
+
+	// Durations before [ActivationTime] at which a warning is logged that the
+	// fork is approaching.
+	ActivationWarnings []time.Duration
+
 	// Block signer
 	StakingLeafSigner crypto.Signer
 
@@ -37,3 +70,10 @@ type Config struct {
 func (c *Config) IsDurangoActivated(timestamp time.Time) bool {
 	return !timestamp.Before(c.DurangoTime)
 }
+
+func (c *Config) freeForAllDelay() time.Duration {
+	if c.FreeForAllDelay == 0 {
+		return proposer.MaxBuildDelay
+	}
+	return min(max(c.FreeForAllDelay, proposer.MaxVerifyDelay), proposer.MaxBuildDelay)
+}
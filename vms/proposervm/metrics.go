@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const (
+	blockTypeLabel = "type"
+
+	preForkBlockType   = "pre_fork_block"
+	postForkBlockType  = "post_fork_block"
+	postForkOptionType = "post_fork_option"
+)
+
+type vmMetrics struct {
+	// pChainHeightFetchAttemptFailures counts every failed attempt to fetch
+	// the P-chain height, including attempts that were later retried.
+	pChainHeightFetchAttemptFailures prometheus.Counter
+	// pChainHeightUnavailable counts the number of times block building was
+	// skipped because every attempt to fetch the P-chain height failed.
+	pChainHeightUnavailable prometheus.Counter
+	// innerBuildBlockRetries counts the number of times building an inner
+	// block was retried after the inner VM failed.
+	innerBuildBlockRetries prometheus.Counter
+
+	// blocksBuilt, blocksParsed, and blocksAccepted count the blocks of each
+	// type to expose the transition across the Snowman++ activation.
+	blocksBuilt    *prometheus.CounterVec
+	blocksParsed   *prometheus.CounterVec
+	blocksAccepted *prometheus.CounterVec
+}
+
+func newVMMetrics(reg prometheus.Registerer) (*vmMetrics, error) {
+	m := &vmMetrics{
+		pChainHeightFetchAttemptFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "p_chain_height_fetch_attempt_failures",
+			Help: "Number of failed attempts to fetch the P-chain height, including attempts that were retried",
+		}),
+		pChainHeightUnavailable: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "p_chain_height_unavailable",
+			Help: "Number of block builds skipped because the P-chain height couldn't be fetched after retrying",
+		}),
+		innerBuildBlockRetries: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "inner_build_block_retries",
+			Help: "Number of times building an inner block was retried after the inner VM failed",
+		}),
+		blocksBuilt: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "blocks_built",
+				Help: "Number of blocks built, by block type",
+			},
+			[]string{blockTypeLabel},
+		),
+		blocksParsed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "blocks_parsed",
+				Help: "Number of new blocks parsed, by block type",
+			},
+			[]string{blockTypeLabel},
+		),
+		blocksAccepted: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "blocks_accepted",
+				Help: "Number of blocks accepted, by block type",
+			},
+			[]string{blockTypeLabel},
+		),
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		reg.Register(m.pChainHeightFetchAttemptFailures),
+		reg.Register(m.pChainHeightUnavailable),
+		reg.Register(m.innerBuildBlockRetries),
+		reg.Register(m.blocksBuilt),
+		reg.Register(m.blocksParsed),
+		reg.Register(m.blocksAccepted),
+	)
+	return m, errs.Err
+}
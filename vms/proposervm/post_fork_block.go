@@ -27,7 +27,11 @@ func (b *postForkBlock) Accept(ctx context.Context) error {
 	if err := b.acceptOuterBlk(); err != nil {
 		return err
 	}
-	return b.acceptInnerBlk(ctx)
+	if err := b.acceptInnerBlk(ctx); err != nil {
+		return err
+	}
+	b.vm.metrics.blocksAccepted.WithLabelValues(postForkBlockType).Inc()
+	return nil
 }
 
 func (b *postForkBlock) acceptOuterBlk() error {
@@ -35,7 +35,11 @@ func (b *postForkOption) Accept(ctx context.Context) error {
 	if err := b.acceptOuterBlk(); err != nil {
 		return err
 	}
-	return b.acceptInnerBlk(ctx)
+	if err := b.acceptInnerBlk(ctx); err != nil {
+		return err
+	}
+	b.vm.metrics.blocksAccepted.WithLabelValues(postForkOptionType).Inc()
+	return nil
 }
 
 func (b *postForkOption) acceptOuterBlk() error {
@@ -33,7 +33,11 @@ func (b *preForkBlock) Accept(ctx context.Context) error {
 	if err := b.acceptOuterBlk(); err != nil {
 		return err
 	}
-	return b.acceptInnerBlk(ctx)
+	if err := b.acceptInnerBlk(ctx); err != nil {
+		return err
+	}
+	b.vm.metrics.blocksAccepted.WithLabelValues(preForkBlockType).Inc()
+	return nil
 }
 
 func (*preForkBlock) acceptOuterBlk() error {
@@ -189,7 +193,7 @@ func (b *preForkBlock) buildChild(ctx context.Context) (Block, error) {
 	parentTimestamp := b.Timestamp()
 	if parentTimestamp.Before(b.vm.ActivationTime) {
 		// The chain hasn't forked yet
-		innerBlock, err := b.vm.ChainVM.BuildBlock(ctx)
+		innerBlock, err := b.vm.buildInnerBlock(ctx, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -199,6 +203,7 @@ func (b *preForkBlock) buildChild(ctx context.Context) (Block, error) {
 			zap.Uint64("height", innerBlock.Height()),
 			zap.Time("parentTimestamp", parentTimestamp),
 		)
+		b.vm.metrics.blocksBuilt.WithLabelValues(preForkBlockType).Inc()
 
 		return &preForkBlock{
 			Block: innerBlock,
@@ -217,6 +222,10 @@ func (b *preForkBlock) buildChild(ctx context.Context) (Block, error) {
 	// The child's P-Chain height is proposed as the optimal P-Chain height that
 	// is at least the minimum height
 	pChainHeight, err := b.vm.optimalPChainHeight(ctx, b.vm.MinimumPChainHeight)
+	if errors.Is(err, errPChainHeightUnavailable) {
+		b.vm.skipBuildBlock(parentID, err)
+		return nil, err
+	}
 	if err != nil {
 		b.vm.ctx.Log.Error("unexpected build block failure",
 			zap.String("reason", "failed to calculate optimal P-chain height"),
@@ -226,7 +235,7 @@ func (b *preForkBlock) buildChild(ctx context.Context) (Block, error) {
 		return nil, err
 	}
 
-	innerBlock, err := b.vm.ChainVM.BuildBlock(ctx)
+	innerBlock, err := b.vm.buildInnerBlock(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -250,13 +259,15 @@ func (b *preForkBlock) buildChild(ctx context.Context) (Block, error) {
 		},
 	}
 
-	b.vm.ctx.Log.Info("built block",
+	b.vm.ctx.Log.Info("built first post-fork block",
 		zap.Stringer("blkID", blk.ID()),
 		zap.Stringer("innerBlkID", innerBlock.ID()),
 		zap.Uint64("height", blk.Height()),
 		zap.Uint64("pChainHeight", pChainHeight),
+		zap.Time("activationTime", b.vm.ActivationTime),
 		zap.Time("parentTimestamp", parentTimestamp),
 		zap.Time("blockTimestamp", newTimestamp))
+	b.vm.metrics.blocksBuilt.WithLabelValues(postForkBlockType).Inc()
 	return blk, nil
 }
 
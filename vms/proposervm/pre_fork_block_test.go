@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -649,12 +650,15 @@ func TestPreForkBlock_BuildBlockWithContext(t *testing.T) {
 	vdrState := validators.NewMockState(ctrl)
 	vdrState.EXPECT().GetMinimumHeight(context.Background()).Return(pChainHeight, nil).AnyTimes()
 
+	metrics, err := newVMMetrics(prometheus.NewRegistry())
+	require.NoError(err)
 	vm := &VM{
 		ChainVM: innerVM,
 		ctx: &snow.Context{
 			ValidatorState: vdrState,
 			Log:            logging.NoLog{},
 		},
+		metrics: metrics,
 	}
 
 	blk := &preForkBlock{
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
+)
+
+var _ utils.Sortable[Proposer] = Proposer{}
+
+// Service is the debug API service of the proposervm. It exposes the
+// Snowman++ proposer schedule so that operators can verify their node's
+// expected proposal window.
+type Service struct {
+	vm *VM
+}
+
+// GetProposerForHeightArgs are the arguments for calling GetProposerForHeight
+type GetProposerForHeightArgs struct {
+	// Height of the block to be proposed
+	Height json.Uint64 `json:"height"`
+	// P-chain height the validator set is defined at. If zero, the current
+	// P-chain height is used.
+	PChainHeight json.Uint64 `json:"pChainHeight"`
+}
+
+// Proposer is the scheduled proposal delay of a validator. Prior to Durango,
+// Delay is the time after which the validator may propose. Once Durango is
+// activated, Delay is the start of the validator's first proposal slot.
+type Proposer struct {
+	NodeID ids.NodeID    `json:"nodeID"`
+	Delay  time.Duration `json:"delay"`
+}
+
+func (p Proposer) Compare(other Proposer) int {
+	if delayCmp := cmp.Compare(p.Delay, other.Delay); delayCmp != 0 {
+		return delayCmp
+	}
+	return p.NodeID.Compare(other.NodeID)
+}
+
+// GetProposerForHeightReply are the results from calling GetProposerForHeight
+type GetProposerForHeightReply struct {
+	PChainHeight json.Uint64 `json:"pChainHeight"`
+	// Validators ordered by their minimum delay before being allowed to
+	// propose a block at the requested height
+	Proposers []Proposer `json:"proposers"`
+}
+
+// GetProposerForHeight returns every validator at the requested P-chain height
+// along with the delay it must wait before building a block at the requested
+// height. The windowing scheme used is selected by whether Durango is active at
+// the parent block's timestamp, mirroring how the VM schedules block building.
+func (s *Service) GetProposerForHeight(r *http.Request, args *GetProposerForHeightArgs, reply *GetProposerForHeightReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "proposervm"),
+		zap.String("method", "getProposerForHeight"),
+		zap.Uint64("height", uint64(args.Height)),
+		zap.Uint64("pChainHeight", uint64(args.PChainHeight)),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	ctx := r.Context()
+	pChainHeight := uint64(args.PChainHeight)
+	if pChainHeight == 0 {
+		currentHeight, err := s.vm.ctx.ValidatorState.GetCurrentHeight(ctx)
+		if err != nil {
+			return err
+		}
+		pChainHeight = currentHeight
+	}
+
+	validators, err := s.vm.ctx.ValidatorState.GetValidatorSet(ctx, pChainHeight, s.vm.ctx.SubnetID)
+	if err != nil {
+		return err
+	}
+
+	parentTimestamp, err := s.getParentTimestamp(ctx, uint64(args.Height))
+	if err != nil {
+		return err
+	}
+	durangoActivated := s.vm.IsDurangoActivated(parentTimestamp)
+
+	reply.PChainHeight = json.Uint64(pChainHeight)
+	reply.Proposers = make([]Proposer, 0, len(validators))
+	for nodeID := range validators {
+		var delay time.Duration
+		if durangoActivated {
+			// Slot zero starts at the parent timestamp. Validators without a
+			// slot in the first [proposer.MaxLookAheadSlots] slots are
+			// reported with the delay of the end of the look ahead.
+			delay, err = s.vm.Windower.MinDelayForProposer(ctx, uint64(args.Height), pChainHeight, nodeID, 0)
+			if errors.Is(err, proposer.ErrAnyoneCanPropose) {
+				// No validator has any weight, so there is no schedule to
+				// report.
+				reply.Proposers = reply.Proposers[:0]
+				return nil
+			}
+		} else {
+			delay, err = s.vm.Windower.Delay(ctx, uint64(args.Height), pChainHeight, nodeID, proposer.MaxBuildWindows)
+		}
+		if err != nil {
+			return err
+		}
+		reply.Proposers = append(reply.Proposers, Proposer{
+			NodeID: nodeID,
+			Delay:  delay,
+		})
+	}
+	utils.Sort(reply.Proposers)
+	return nil
+}
+
+// getParentTimestamp returns the timestamp of the accepted block at
+// [height]-1. If that block hasn't been accepted yet, the timestamp of the
+// preferred block is used instead.
+//
+// vm.ctx.Lock should be held
+func (s *Service) getParentTimestamp(ctx context.Context, height uint64) (time.Time, error) {
+	parentID := s.vm.preferred
+	if height > 0 {
+		switch blkID, err := s.vm.GetBlockIDAtHeight(ctx, height-1); err {
+		case nil:
+			parentID = blkID
+		case database.ErrNotFound:
+		default:
+			return time.Time{}, err
+		}
+	}
+
+	parent, err := s.vm.getBlock(ctx, parentID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parent.Timestamp(), nil
+}
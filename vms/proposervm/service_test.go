@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
+)
+
+func TestServiceGetProposerForHeightPreDurango(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, _, proVM, _ := initTestProposerVM(t, time.Time{}, mockable.MaxTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+	coreVM.GetBlockIDAtHeightF = func(context.Context, uint64) (ids.ID, error) {
+		return ids.Empty, database.ErrNotFound
+	}
+
+	service := &Service{vm: proVM}
+	args := &GetProposerForHeightArgs{
+		Height: 10,
+	}
+	reply := &GetProposerForHeightReply{}
+	require.NoError(service.GetProposerForHeight(&http.Request{}, args, reply))
+
+	require.Equal(json.Uint64(defaultPChainHeight), reply.PChainHeight)
+	require.Len(reply.Proposers, 4)
+	require.True(utils.IsSortedAndUnique(reply.Proposers))
+	for _, p := range reply.Proposers {
+		expectedDelay, err := proVM.Windower.Delay(
+			context.Background(),
+			uint64(args.Height),
+			defaultPChainHeight,
+			p.NodeID,
+			proposer.MaxBuildWindows,
+		)
+		require.NoError(err)
+		require.Equal(expectedDelay, p.Delay)
+	}
+}
+
+func TestServiceGetProposerForHeightPostDurango(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, _, proVM, _ := initTestProposerVM(t, time.Time{}, time.Time{}, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+	coreVM.GetBlockIDAtHeightF = func(context.Context, uint64) (ids.ID, error) {
+		return ids.Empty, database.ErrNotFound
+	}
+
+	service := &Service{vm: proVM}
+	args := &GetProposerForHeightArgs{
+		Height: 10,
+	}
+	reply := &GetProposerForHeightReply{}
+	require.NoError(service.GetProposerForHeight(&http.Request{}, args, reply))
+
+	require.Equal(json.Uint64(defaultPChainHeight), reply.PChainHeight)
+	require.Len(reply.Proposers, 4)
+	require.True(utils.IsSortedAndUnique(reply.Proposers))
+
+	// The first proposer must be the one expected in slot zero.
+	expectedProposer, err := proVM.Windower.ExpectedProposer(
+		context.Background(),
+		uint64(args.Height),
+		defaultPChainHeight,
+		0,
+	)
+	require.NoError(err)
+	require.Equal(expectedProposer, reply.Proposers[0].NodeID)
+	require.Zero(reply.Proposers[0].Delay)
+
+	for _, p := range reply.Proposers {
+		expectedDelay, err := proVM.Windower.MinDelayForProposer(
+			context.Background(),
+			uint64(args.Height),
+			defaultPChainHeight,
+			p.NodeID,
+			0,
+		)
+		require.NoError(err)
+		require.Equal(expectedDelay, p.Delay)
+	}
+}
+
+func TestCreateHandlersDebugAPI(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, _, proVM, _ := initTestProposerVM(t, time.Time{}, mockable.MaxTime, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	coreVM.CreateHandlersF = func(context.Context) (map[string]http.Handler, error) {
+		return map[string]http.Handler{
+			"": http.NotFoundHandler(),
+		}, nil
+	}
+
+	handlers, err := proVM.CreateHandlers(context.Background())
+	require.NoError(err)
+	require.NotContains(handlers, apiEndpoint)
+
+	proVM.DebugAPIEnabled = true
+	handlers, err = proVM.CreateHandlers(context.Background())
+	require.NoError(err)
+	require.Contains(handlers, "")
+	require.Contains(handlers, apiEndpoint)
+}
@@ -4,11 +4,16 @@
 package proposervm
 
 import (
+	"cmp"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
 	"time"
 
+	"github.com/gorilla/rpc/v2"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
@@ -25,6 +30,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
@@ -43,9 +49,41 @@ const (
 	// DefaultNumHistoricalBlocks as 0 results in never deleting any historical
 	// blocks.
 	DefaultNumHistoricalBlocks uint64 = 0
+	// DefaultBuildBlockRetries is the default number of additional attempts
+	// made to build an inner block.
+	DefaultBuildBlockRetries = 2
+	// DefaultBuildBlockRetryBackoff is the default initial delay between
+	// attempts to build an inner block.
+	DefaultBuildBlockRetryBackoff = 10 * time.Millisecond
+	// DefaultFreeForAllDelay is the default delay after which this node builds
+	// an unsigned block pre-Durango.
+	DefaultFreeForAllDelay = proposer.MaxBuildDelay
+	// DefaultBuildJitter is the default upper bound of the delay added to the
+	// scheduled block building time pre-Durango. Zero disables the jitter.
+	DefaultBuildJitter time.Duration = 0
 
 	checkIndexedFrequency = 10 * time.Second
 	innerBlkCacheSize     = 64 * units.MiB
+
+	// pChainHeightRetries is the number of additional attempts made to fetch
+	// the P-chain height before giving up on building a block.
+	pChainHeightRetries = 2
+	// pChainHeightRetryBackoff is the initial delay between attempts to fetch
+	// the P-chain height. The delay doubles after every failed attempt.
+	pChainHeightRetryBackoff = 10 * time.Millisecond
+	// pChainHeightRetryBudget bounds the total time spent waiting between
+	// attempts to fetch the P-chain height during a single BuildBlock call.
+	pChainHeightRetryBudget = 30 * time.Millisecond
+	// pChainHeightRebuildDelay is how long block building is deferred after
+	// the P-chain height could not be fetched.
+	pChainHeightRebuildDelay = time.Second
+
+	// schedulerMaxRestarts is the number of times the scheduler is restarted
+	// after panicking before the panic is rethrown.
+	schedulerMaxRestarts = 3
+	// schedulerRestartBackoff is the initial delay before restarting the
+	// scheduler after it panicked. The delay doubles after every restart.
+	schedulerRestartBackoff = time.Second
 )
 
 var (
@@ -54,6 +92,17 @@ var (
 	_ block.StateSyncableVM = (*VM)(nil)
 
 	dbPrefix = []byte("proposervm")
+
+	// DefaultActivationWarnings are the durations before the activation time
+	// at which a warning is logged that the fork is approaching.
+	DefaultActivationWarnings = []time.Duration{
+		time.Hour,
+		10 * time.Minute,
+		time.Minute,
+	}
+
+	errPChainHeightUnavailable = errors.New("P-chain height unavailable")
+	errDuplicateAPIEndpoint    = errors.New("duplicate API endpoint")
 )
 
 func cachedBlockSize(_ ids.ID, blk snowman.Block) int {
@@ -77,6 +126,16 @@ type VM struct {
 	ctx         *snow.Context
 	db          *versiondb.Database
 	toScheduler chan<- common.Message
+	metrics     *vmMetrics
+
+	// pChainHeightRetryBackoff is the initial delay between attempts to fetch
+	// the P-chain height. It is only modified in tests.
+	pChainHeightRetryBackoff time.Duration
+
+	// buildJitter is added to every scheduled block building time pre-Durango
+	// to avoid validators with the same delay from building blocks
+	// simultaneously.
+	buildJitter time.Duration
 
 	// Block ID --> Block
 	// Each element is a block that passed verification but
@@ -116,6 +175,8 @@ func New(
 		blockBuilderVM: blockBuilderVM,
 		batchedVM:      batchedVM,
 		ssVM:           ssVM,
+
+		pChainHeightRetryBackoff: pChainHeightRetryBackoff,
 	}
 }
 
@@ -143,11 +204,16 @@ func (vm *VM) Initialize(
 	chainCtx.Metrics = multiGatherer
 
 	vm.ctx = chainCtx
+	vm.buildJitter = nodeBuildJitter(chainCtx.NodeID, vm.BuildJitter)
 	vm.db = versiondb.New(prefixdb.New(dbPrefix, db))
 	baseState, err := state.NewMetered(vm.db, "state", registerer)
 	if err != nil {
 		return err
 	}
+	vm.metrics, err = newVMMetrics(registerer)
+	if err != nil {
+		return err
+	}
 	vm.State = baseState
 	vm.Windower = proposer.New(chainCtx.ValidatorState, chainCtx.SubnetID, chainCtx.ChainID)
 	vm.Tree = tree.New()
@@ -168,9 +234,13 @@ func (vm *VM) Initialize(
 	vm.Scheduler = scheduler
 	vm.toScheduler = vmToEngine
 
-	go chainCtx.Log.RecoverAndPanic(func() {
-		scheduler.Dispatch(time.Now())
-	})
+	go chainCtx.Log.RecoverAndRestart(
+		func() {
+			scheduler.Dispatch(time.Now())
+		},
+		schedulerMaxRestarts,
+		schedulerRestartBackoff,
+	)
 
 	vm.verifiedBlocks = make(map[ids.ID]PostForkBlock)
 	detachedCtx := context.WithoutCancel(ctx)
@@ -217,6 +287,7 @@ func (vm *VM) Initialize(
 		chainCtx.Log.Info("initialized proposervm",
 			zap.String("state", "before fork"),
 		)
+		go chainCtx.Log.RecoverAndPanic(vm.warnOfActivation)
 	default:
 		return err
 	}
@@ -232,7 +303,45 @@ func (vm *VM) Shutdown(ctx context.Context) error {
 	if err := vm.db.Commit(); err != nil {
 		return err
 	}
-	return vm.ChainVM.Shutdown(ctx)
+	if err := vm.ChainVM.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	// The inner VM may still reference proposervm state while shutting down,
+	// so the database is only released once the inner VM has stopped.
+	return vm.db.Close()
+}
+
+// apiEndpoint is the extension the proposervm debug API is served on. It is
+// registered next to the handlers of the inner VM.
+const apiEndpoint = "/proposervm"
+
+// CreateHandlers returns the handlers of the inner VM and, if enabled, the
+// proposervm debug API.
+func (vm *VM) CreateHandlers(ctx context.Context) (map[string]http.Handler, error) {
+	handlers, err := vm.ChainVM.CreateHandlers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !vm.DebugAPIEnabled {
+		return handlers, nil
+	}
+	if _, ok := handlers[apiEndpoint]; ok {
+		return nil, fmt.Errorf("%w: %s", errDuplicateAPIEndpoint, apiEndpoint)
+	}
+
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	server.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+	if err := server.RegisterService(&Service{vm: vm}, "proposervm"); err != nil {
+		return nil, err
+	}
+
+	if handlers == nil {
+		handlers = make(map[string]http.Handler)
+	}
+	handlers[apiEndpoint] = server
+	return handlers, nil
 }
 
 func (vm *VM) SetState(ctx context.Context, newState snow.State) error {
@@ -322,6 +431,9 @@ func (vm *VM) SetPreference(ctx context.Context, preferred ids.ID) error {
 			pChainHeight,
 			parentTimestamp,
 		)
+		// Post-Durango, every slot has a single proposer, so only the
+		// pre-Durango windows that are shared by validators are jittered.
+		nextStartTime = nextStartTime.Add(vm.buildJitter)
 	}
 	if err != nil {
 		vm.ctx.Log.Debug("failed to fetch the expected delay",
@@ -344,6 +456,20 @@ func (vm *VM) SetPreference(ctx context.Context, preferred ids.ID) error {
 	return nil
 }
 
+// nodeBuildJitter returns the jitter this node adds to its scheduled block
+// building time. The jitter is derived from [nodeID] so that it is consistent
+// across restarts. It is capped to the duration of a single proposer window.
+func nodeBuildJitter(nodeID ids.NodeID, maxJitter time.Duration) time.Duration {
+	maxJitter = min(maxJitter, proposer.WindowDuration)
+	if maxJitter <= 0 {
+		return 0
+	}
+
+	// NodeIDs are hashes, so their bytes are uniformly distributed.
+	seed := binary.BigEndian.Uint64(nodeID[:])
+	return time.Duration(seed % uint64(maxJitter))
+}
+
 func (vm *VM) getPreDurangoSlotTime(
 	ctx context.Context,
 	blkHeight,
@@ -355,6 +481,10 @@ func (vm *VM) getPreDurangoSlotTime(
 		return time.Time{}, err
 	}
 
+	// If the designated proposers haven't built a block by the free-for-all
+	// delay, this node builds an unsigned block to maintain liveness.
+	delay = min(delay, vm.freeForAllDelay())
+
 	// Note: The P-chain does not currently try to target any block time. It
 	// notifies the consensus engine as soon as a new block may be built. To
 	// avoid fast runs of blocks there is an additional minimum delay that
@@ -538,6 +668,7 @@ func (vm *VM) parsePostForkBlock(ctx context.Context, b []byte) (PostForkBlock,
 				status:   choices.Processing,
 			},
 		}
+		vm.metrics.blocksParsed.WithLabelValues(postForkBlockType).Inc()
 	} else {
 		blk = &postForkOption{
 			Block: statelessBlock,
@@ -547,12 +678,16 @@ func (vm *VM) parsePostForkBlock(ctx context.Context, b []byte) (PostForkBlock,
 				status:   choices.Processing,
 			},
 		}
+		vm.metrics.blocksParsed.WithLabelValues(postForkOptionType).Inc()
 	}
 	return blk, nil
 }
 
 func (vm *VM) parsePreForkBlock(ctx context.Context, b []byte) (*preForkBlock, error) {
 	blk, err := vm.ChainVM.ParseBlock(ctx, b)
+	if err == nil {
+		vm.metrics.blocksParsed.WithLabelValues(preForkBlockType).Inc()
+	}
 	return &preForkBlock{
 		Block: blk,
 		vm:    vm,
@@ -695,7 +830,7 @@ func (vm *VM) notifyInnerBlockReady() {
 }
 
 func (vm *VM) optimalPChainHeight(ctx context.Context, minPChainHeight uint64) (uint64, error) {
-	minimumHeight, err := vm.ctx.ValidatorState.GetMinimumHeight(ctx)
+	minimumHeight, err := vm.getMinimumPChainHeight(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -703,6 +838,147 @@ func (vm *VM) optimalPChainHeight(ctx context.Context, minPChainHeight uint64) (
 	return max(minimumHeight, minPChainHeight), nil
 }
 
+// getMinimumPChainHeight fetches the minimum P-chain height from the validator
+// state. Because the P-chain may transiently fail to report its height, such as
+// while it is bootstrapping, the request is retried with a short backoff. If
+// every attempt fails, [errPChainHeightUnavailable] is returned.
+//
+// This is called from BuildBlock, where the engine holds the chain's lock, so
+// every retry stalls message handling for the chain. The total time spent
+// waiting is therefore bounded by [pChainHeightRetryBudget].
+func (vm *VM) getMinimumPChainHeight(ctx context.Context) (uint64, error) {
+	var (
+		backoff = vm.pChainHeightRetryBackoff
+		waited  time.Duration
+	)
+	for attempt := 0; ; attempt++ {
+		height, err := vm.ctx.ValidatorState.GetMinimumHeight(ctx)
+		if err == nil {
+			return height, nil
+		}
+
+		vm.metrics.pChainHeightFetchAttemptFailures.Inc()
+		if attempt >= pChainHeightRetries || waited+backoff > pChainHeightRetryBudget {
+			vm.metrics.pChainHeightUnavailable.Inc()
+			return 0, fmt.Errorf("%w: %w", errPChainHeightUnavailable, err)
+		}
+
+		vm.ctx.Log.Debug("failed to fetch P-chain height",
+			zap.Int("attempt", attempt+1),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			vm.metrics.pChainHeightUnavailable.Inc()
+			return 0, fmt.Errorf("%w: %w", errPChainHeightUnavailable, ctx.Err())
+		}
+		waited += backoff
+		backoff *= 2
+	}
+}
+
+// buildInnerBlock builds a block using the inner VM. If [blockCtx] is provided
+// and the inner VM supports it, the block is built with the provided context.
+//
+// Failures that may be transient are retried up to [BuildBlockRetries] times
+// with an exponential backoff. As this is called from BuildBlock, where the
+// engine holds the chain's lock, the retries should be kept short. If every
+// attempt fails, the last error of the inner VM is returned unmodified.
+func (vm *VM) buildInnerBlock(ctx context.Context, blockCtx *block.Context) (snowman.Block, error) {
+	backoff := vm.BuildBlockRetryBackoff
+	for attempt := 0; ; attempt++ {
+		var (
+			innerBlock snowman.Block
+			err        error
+		)
+		if blockCtx != nil && vm.blockBuilderVM != nil {
+			innerBlock, err = vm.blockBuilderVM.BuildBlockWithContext(ctx, blockCtx)
+		} else {
+			innerBlock, err = vm.ChainVM.BuildBlock(ctx)
+		}
+		if err == nil || attempt >= vm.BuildBlockRetries || !isTransientBuildError(err) {
+			return innerBlock, err
+		}
+
+		vm.metrics.innerBuildBlockRetries.Inc()
+		vm.ctx.Log.Debug("retrying inner block build",
+			zap.Int("attempt", attempt+1),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+// isTransientBuildError returns true if the inner VM reported that retrying to
+// build a block after [err] may succeed.
+func isTransientBuildError(err error) bool {
+	return errors.Is(err, block.ErrTransientBuildFailure)
+}
+
+// warnOfActivation logs a warning at each of the configured durations before
+// the activation time, until either the activation time is reached or the VM
+// is shut down.
+func (vm *VM) warnOfActivation() {
+	warnings := slices.Clone(vm.ActivationWarnings)
+	// Sort the warnings so that the earliest warning is logged first.
+	slices.SortFunc(warnings, func(a, b time.Duration) int {
+		return cmp.Compare(b, a)
+	})
+
+	for _, warning := range warnings {
+		timeUntilActivation := vm.ActivationTime.Sub(vm.Time())
+		if timeUntilActivation < warning {
+			// This warning's time has already passed.
+			continue
+		}
+
+		timer := time.NewTimer(timeUntilActivation - warning)
+		select {
+		case <-timer.C:
+		case <-vm.context.Done():
+			timer.Stop()
+			return
+		}
+
+		vm.ctx.Log.Warn("snowman++ activation is approaching",
+			zap.Time("activationTime", vm.ActivationTime),
+			zap.Duration("timeUntilActivation", warning),
+		)
+	}
+}
+
+// skipBuildBlock is called when a block couldn't be built because the P-chain
+// height was unavailable. Rather than dropping the build request, the engine is
+// re-notified after [pChainHeightRebuildDelay] so that block building is
+// retried once the P-chain has had a chance to recover.
+//
+// The error is still returned from BuildBlock, which the engine only logs at
+// debug level before moving on to its next event.
+func (vm *VM) skipBuildBlock(parentID ids.ID, err error) {
+	vm.ctx.Log.Warn("skipping block building",
+		zap.String("reason", "P-chain height unavailable"),
+		zap.Stringer("parentID", parentID),
+		zap.Duration("retryIn", pChainHeightRebuildDelay),
+		zap.Error(err),
+	)
+	vm.Scheduler.SetBuildBlockTime(vm.Time().Add(pChainHeightRebuildDelay))
+	vm.notifyInnerBlockReady()
+}
+
 // parseInnerBlock attempts to parse the provided bytes as an inner block. If
 // the inner block happens to be cached, then the inner block will not be
 // parsed.
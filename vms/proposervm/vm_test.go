@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -57,6 +58,7 @@ var (
 	errMarshallingFailed = errors.New("marshalling failed")
 	errTooHigh           = errors.New("too high")
 	errUnexpectedCall    = errors.New("unexpected call")
+	errPChainHeight      = errors.New("failed to fetch P-chain height")
 )
 
 func init() {
@@ -287,6 +289,126 @@ func TestBuildBlockIsIdempotent(t *testing.T) {
 	require.Equal(builtBlk1.Bytes(), builtBlk2.Bytes())
 }
 
+func TestBuildBlockPChainHeightRetries(t *testing.T) {
+	type test struct {
+		name string
+		// buildOnPostForkBlock builds on top of a post-fork block rather than
+		// genesis, which is a pre-fork block.
+		buildOnPostForkBlock bool
+		// numFailures is the number of times fetching the P-chain height fails
+		// before succeeding.
+		numFailures                int
+		cancelDuringBackoff        bool
+		expectedErr                error
+		expectedCalls              int
+		expectedAttemptFailures    float64
+		expectedHeightsUnavailable float64
+	}
+	tests := []test{
+		{
+			name:                       "pre-fork parent recovers after retry",
+			numFailures:                1,
+			expectedCalls:              2,
+			expectedAttemptFailures:    1,
+			expectedHeightsUnavailable: 0,
+		},
+		{
+			name:                       "pre-fork parent exhausts retries",
+			numFailures:                pChainHeightRetries + 1,
+			expectedErr:                errPChainHeightUnavailable,
+			expectedCalls:              pChainHeightRetries + 1,
+			expectedAttemptFailures:    pChainHeightRetries + 1,
+			expectedHeightsUnavailable: 1,
+		},
+		{
+			name:                       "post-fork parent exhausts retries",
+			buildOnPostForkBlock:       true,
+			numFailures:                pChainHeightRetries + 1,
+			expectedErr:                errPChainHeightUnavailable,
+			expectedCalls:              pChainHeightRetries + 1,
+			expectedAttemptFailures:    pChainHeightRetries + 1,
+			expectedHeightsUnavailable: 1,
+		},
+		{
+			name:                       "context cancelled during backoff",
+			numFailures:                1,
+			cancelDuringBackoff:        true,
+			expectedErr:                context.Canceled,
+			expectedCalls:              1,
+			expectedAttemptFailures:    1,
+			expectedHeightsUnavailable: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var (
+				activationTime = time.Unix(0, 0)
+				durangoTime    = activationTime
+			)
+			coreVM, valState, proVM, _ := initTestProposerVM(t, activationTime, durangoTime, 0)
+			defer func() {
+				require.NoError(proVM.Shutdown(context.Background()))
+			}()
+
+			// Avoid sleeping on the wall clock between retries, unless the
+			// context is expected to be cancelled during the backoff.
+			proVM.pChainHeightRetryBackoff = 0
+			if test.cancelDuringBackoff {
+				proVM.pChainHeightRetryBackoff = pChainHeightRetryBudget
+			}
+
+			parentCoreBlk := snowmantest.Genesis
+			if test.buildOnPostForkBlock {
+				coreBlk := snowmantest.BuildChild(snowmantest.Genesis)
+				coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+					return coreBlk, nil
+				}
+				coreVM.SetPreferenceF = func(context.Context, ids.ID) error {
+					return nil
+				}
+
+				proBlk, err := proVM.BuildBlock(context.Background())
+				require.NoError(err)
+				require.IsType(&postForkBlock{}, proBlk)
+				require.NoError(proBlk.Verify(context.Background()))
+				require.NoError(proVM.SetPreference(context.Background(), proBlk.ID()))
+				parentCoreBlk = coreBlk
+			}
+
+			coreBlk := snowmantest.BuildChild(parentCoreBlk)
+			coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+				return coreBlk, nil
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			numCalls := 0
+			valState.GetMinimumHeightF = func(context.Context) (uint64, error) {
+				numCalls++
+				if numCalls > test.numFailures {
+					return snowmantest.GenesisHeight, nil
+				}
+				if test.cancelDuringBackoff {
+					cancel()
+				}
+				return 0, errPChainHeight
+			}
+
+			_, err := proVM.BuildBlock(ctx)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				require.ErrorIs(err, errPChainHeightUnavailable)
+			}
+			require.Equal(test.expectedCalls, numCalls)
+			require.Equal(test.expectedAttemptFailures, testutil.ToFloat64(proVM.metrics.pChainHeightFetchAttemptFailures))
+			require.Equal(test.expectedHeightsUnavailable, testutil.ToFloat64(proVM.metrics.pChainHeightUnavailable))
+		})
+	}
+}
+
 func TestFirstProposerBlockIsBuiltOnTopOfGenesis(t *testing.T) {
 	require := require.New(t)
 
@@ -2497,3 +2619,230 @@ func TestHistoricalBlockDeletion(t *testing.T) {
 	issueBlock()
 	requireNumHeights(newNumHistoricalBlocks)
 }
+
+func TestShutdownCommitsAndClosesDB(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, _, proVM, db := initTestProposerVM(t, time.Time{}, mockable.MaxTime, 0)
+
+	coreBlk := snowmantest.BuildChild(snowmantest.Genesis)
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return coreBlk, nil
+	}
+	builtBlk, err := proVM.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(builtBlk.Verify(context.Background()))
+	require.NoError(builtBlk.Accept(context.Background()))
+
+	var (
+		key   = []byte("key")
+		value = []byte("value")
+	)
+	require.NoError(proVM.db.Put(key, value))
+	require.NoError(proVM.Shutdown(context.Background()))
+
+	// Pending writes must be flushed to the underlying database.
+	gotValue, err := prefixdb.New(dbPrefix, db).Get(key)
+	require.NoError(err)
+	require.Equal(value, gotValue)
+
+	// The versiondb must be released once the VM has shut down.
+	_, err = proVM.db.Has(key)
+	require.ErrorIs(err, database.ErrClosed)
+
+	// The accepted chain must survive a restart on the same database.
+	coreVM.InitializeF = func(context.Context, *snow.Context, database.Database,
+		[]byte, []byte, []byte, chan<- common.Message,
+		[]*common.Fx, common.AppSender,
+	) error {
+		return nil
+	}
+	coreVM.LastAcceptedF = func(context.Context) (ids.ID, error) {
+		return coreBlk.ID(), nil
+	}
+	coreVM.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case snowmantest.GenesisID:
+			return snowmantest.Genesis, nil
+		case coreBlk.ID():
+			return coreBlk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	coreVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		switch {
+		case bytes.Equal(b, snowmantest.GenesisBytes):
+			return snowmantest.Genesis, nil
+		case bytes.Equal(b, coreBlk.Bytes()):
+			return coreBlk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+
+	restartedVM := New(coreVM, proVM.Config)
+	require.NoError(restartedVM.Initialize(
+		context.Background(),
+		proVM.ctx,
+		db,
+		[]byte("genesis state"),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	))
+	defer func() {
+		require.NoError(restartedVM.Shutdown(context.Background()))
+	}()
+
+	lastAcceptedID, err := restartedVM.LastAccepted(context.Background())
+	require.NoError(err)
+	require.Equal(builtBlk.ID(), lastAcceptedID)
+
+	blkID, err := restartedVM.GetBlockIDAtHeight(context.Background(), builtBlk.Height())
+	require.NoError(err)
+	require.Equal(builtBlk.ID(), blkID)
+
+	lastAccepted, err := restartedVM.GetBlock(context.Background(), lastAcceptedID)
+	require.NoError(err)
+	require.Equal(builtBlk.Height(), lastAccepted.Height())
+}
+
+func TestNodeBuildJitter(t *testing.T) {
+	require := require.New(t)
+
+	const maxJitter = proposer.WindowDuration / 10
+	nodeID := ids.GenerateTestNodeID()
+
+	// The jitter must be consistent across restarts.
+	jitter := nodeBuildJitter(nodeID, maxJitter)
+	require.Equal(jitter, nodeBuildJitter(nodeID, maxJitter))
+	require.GreaterOrEqual(jitter, time.Duration(0))
+	require.Less(jitter, maxJitter)
+
+	// The jitter must never exceed a single proposer window.
+	require.Less(nodeBuildJitter(nodeID, time.Hour), proposer.WindowDuration)
+
+	require.Zero(nodeBuildJitter(nodeID, 0))
+}
+
+func TestBlockTypeMetrics(t *testing.T) {
+	tests := []struct {
+		name           string
+		activationTime time.Time
+		expectedType   string
+	}{
+		{
+			name:           "pre-fork",
+			activationTime: mockable.MaxTime,
+			expectedType:   preForkBlockType,
+		},
+		{
+			name:           "post-fork",
+			activationTime: time.Unix(0, 0),
+			expectedType:   postForkBlockType,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			coreVM, _, proVM, _ := initTestProposerVM(t, test.activationTime, mockable.MaxTime, 0)
+			defer func() {
+				require.NoError(proVM.Shutdown(context.Background()))
+			}()
+
+			coreBlk := snowmantest.BuildChild(snowmantest.Genesis)
+			coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+				return coreBlk, nil
+			}
+
+			builtBlk, err := proVM.BuildBlock(context.Background())
+			require.NoError(err)
+			require.NoError(builtBlk.Verify(context.Background()))
+			require.NoError(builtBlk.Accept(context.Background()))
+
+			for _, blockType := range []string{preForkBlockType, postForkBlockType, postForkOptionType} {
+				var expected float64
+				if blockType == test.expectedType {
+					expected = 1
+				}
+				require.Equal(expected, testutil.ToFloat64(proVM.metrics.blocksBuilt.WithLabelValues(blockType)))
+				require.Equal(expected, testutil.ToFloat64(proVM.metrics.blocksAccepted.WithLabelValues(blockType)))
+			}
+		})
+	}
+}
+
+func TestBuildBlockRetriesInnerVMFailures(t *testing.T) {
+	errTransient := fmt.Errorf("%w: mempool is being reset", block.ErrTransientBuildFailure)
+	errNoPendingBlocks := errors.New("no pending blocks")
+
+	tests := []struct {
+		name             string
+		buildErr         error
+		failures         int
+		expectedErr      error
+		expectedAttempts int
+	}{
+		{
+			name:             "transient failure recovers",
+			buildErr:         errTransient,
+			failures:         2,
+			expectedErr:      nil,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "transient failure exceeds retries",
+			buildErr:         errTransient,
+			failures:         3,
+			expectedErr:      errTransient,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "permanent failure is not retried",
+			buildErr:         database.ErrClosed,
+			failures:         1,
+			expectedErr:      database.ErrClosed,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "no pending blocks is not retried",
+			buildErr:         errNoPendingBlocks,
+			failures:         1,
+			expectedErr:      errNoPendingBlocks,
+			expectedAttempts: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			coreVM, _, proVM, _ := initTestProposerVM(t, mockable.MaxTime, mockable.MaxTime, 0)
+			defer func() {
+				require.NoError(proVM.Shutdown(context.Background()))
+			}()
+			proVM.BuildBlockRetries = 2
+			proVM.BuildBlockRetryBackoff = time.Millisecond
+
+			var (
+				coreBlk  = snowmantest.BuildChild(snowmantest.Genesis)
+				attempts int
+			)
+			coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+				attempts++
+				if attempts <= test.failures {
+					return nil, test.buildErr
+				}
+				return coreBlk, nil
+			}
+
+			_, err := proVM.BuildBlock(context.Background())
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedAttempts, attempts)
+			require.Equal(float64(test.expectedAttempts-1), testutil.ToFloat64(proVM.metrics.innerBuildBlockRetries))
+		})
+	}
+}
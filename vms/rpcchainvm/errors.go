@@ -4,6 +4,12 @@
 package rpcchainvm
 
 import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 
@@ -29,3 +35,23 @@ func errorToRPCError(err error) error {
 	}
 	return err
 }
+
+// buildBlockErrorToRPCError reports block build failures that the VM marked as
+// transient with [codes.Unavailable], so that the client can retry them.
+func buildBlockErrorToRPCError(err error) error {
+	if errors.Is(err, block.ErrTransientBuildFailure) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return err
+}
+
+// rpcErrorToBuildBlockError marks block build failures reported with
+// [codes.Unavailable] as transient. gRPC reports this code when the plugin is
+// temporarily unreachable, and the server reports it when the VM marked the
+// failure as transient.
+func rpcErrorToBuildBlockError(err error) error {
+	if status.Code(err) == codes.Unavailable {
+		return fmt.Errorf("%w: %w", block.ErrTransientBuildFailure, err)
+	}
+	return err
+}
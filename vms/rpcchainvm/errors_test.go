@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpcchainvm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+func TestBuildBlockErrorRoundTrip(t *testing.T) {
+	errTest := errors.New("non-nil error")
+	tests := []struct {
+		name              string
+		err               error
+		expectedTransient bool
+	}{
+		{
+			name:              "transient",
+			err:               fmt.Errorf("%w: mempool is being reset", block.ErrTransientBuildFailure),
+			expectedTransient: true,
+		},
+		{
+			name:              "no pending blocks",
+			err:               errTest,
+			expectedTransient: false,
+		},
+		{
+			name:              "plugin unavailable",
+			err:               status.Error(codes.Unavailable, "connection refused"),
+			expectedTransient: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := rpcErrorToBuildBlockError(buildBlockErrorToRPCError(test.err))
+			require.Equal(t, test.expectedTransient, errors.Is(err, block.ErrTransientBuildFailure))
+		})
+	}
+}
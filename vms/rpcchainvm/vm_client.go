@@ -388,7 +388,7 @@ func (vm *VMClient) buildBlockWithContext(ctx context.Context, blockCtx *block.C
 		PChainHeight: &blockCtx.PChainHeight,
 	})
 	if err != nil {
-		return nil, err
+		return nil, rpcErrorToBuildBlockError(err)
 	}
 	return vm.newBlockFromBuildBlock(resp)
 }
@@ -396,7 +396,7 @@ func (vm *VMClient) buildBlockWithContext(ctx context.Context, blockCtx *block.C
 func (vm *VMClient) buildBlock(ctx context.Context) (snowman.Block, error) {
 	resp, err := vm.client.BuildBlock(ctx, &vmpb.BuildBlockRequest{})
 	if err != nil {
-		return nil, err
+		return nil, rpcErrorToBuildBlockError(err)
 	}
 	return vm.newBlockFromBuildBlock(resp)
 }
@@ -373,7 +373,7 @@ func (vm *VMServer) BuildBlock(ctx context.Context, req *vmpb.BuildBlockRequest)
 		})
 	}
 	if err != nil {
-		return nil, err
+		return nil, buildBlockErrorToRPCError(err)
 	}
 
 	blkWithCtx, verifyWithCtx := blk.(block.WithVerifyContext)
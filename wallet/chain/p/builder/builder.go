@@ -4,9 +4,11 @@
 package builder
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
@@ -29,10 +31,44 @@ var (
 	ErrUnknownOwnerType          = errors.New("unknown owner type")
 	ErrInsufficientAuthorization = errors.New("insufficient authorization")
 	ErrInsufficientFunds         = errors.New("insufficient funds")
+	ErrTooFewUTXOs               = errors.New("too few UTXOs to consolidate")
+	ErrTooManyInputs             = errors.New("too many inputs")
+	ErrNoRewardsOwner            = errors.New("no rewards owner")
 
 	_ Builder = (*builder)(nil)
 )
 
+// InsufficientFundsError reports how much of an asset is missing to fund a
+// transaction. It matches [ErrInsufficientFunds] with [errors.Is].
+type InsufficientFundsError struct {
+	AssetID ids.ID
+	// Required is the amount of the asset needed to fund the transaction.
+	Required uint64
+	// Available is the amount of the asset that the wallet is able to spend.
+	Available uint64
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf(
+		"%s: need %d more units of asset %q (required %d, available %d)",
+		ErrInsufficientFunds,
+		e.Shortfall(),
+		e.AssetID,
+		e.Required,
+		e.Available,
+	)
+}
+
+func (e *InsufficientFundsError) Unwrap() error {
+	return ErrInsufficientFunds
+}
+
+// Shortfall returns the additional amount of the asset needed to fund the
+// transaction.
+func (e *InsufficientFundsError) Shortfall() uint64 {
+	return e.Required - e.Available
+}
+
 // Builder provides a convenient interface for building unsigned P-chain
 // transactions.
 type Builder interface {
@@ -59,17 +95,36 @@ type Builder interface {
 	//
 	// - [outputs] specifies all the recipients and amounts that should be sent
 	//   from this transaction.
+	//
+	// By default, the tx fee is paid in addition to the [outputs]. This can be
+	// changed with [common.WithFeeMode].
 	NewBaseTx(
 		outputs []*avax.TransferableOutput,
 		options ...common.Option,
 	) (*txs.BaseTx, error)
 
+	// NewConsolidationTx creates a simple value transfer that merges the
+	// smallest unlocked AVAX UTXOs into a single output owned by the change
+	// owner.
+	//
+	// - [maxUTXOs] specifies the maximum number of UTXOs to consume. At least
+	//   two UTXOs must be consumed for the number of UTXOs to be reduced. It
+	//   is capped to the maximum number of inputs of a tx, so consolidating
+	//   more UTXOs requires multiple txs.
+	//
+	// The tx fee is deducted from the consolidated funds.
+	NewConsolidationTx(
+		maxUTXOs int,
+		options ...common.Option,
+	) (*txs.BaseTx, error)
+
 	// NewAddValidatorTx creates a new validator of the primary network.
 	//
 	// - [vdr] specifies all the details of the validation period such as the
 	//   startTime, endTime, stake weight, and nodeID.
 	// - [rewardsOwner] specifies the owner of all the rewards this validator
-	//   may accrue during its validation period.
+	//   may accrue during its validation period. If nil, the default rewards
+	//   owner is used.
 	// - [shares] specifies the fraction (out of 1,000,000) that this validator
 	//   will take from delegation rewards. If 1,000,000 is provided, 100% of
 	//   the delegation reward will be sent to the validator's [rewardsOwner].
@@ -148,10 +203,15 @@ type Builder interface {
 	) (*txs.TransferSubnetOwnershipTx, error)
 
 	// NewImportTx creates an import transaction that attempts to consume all
-	// the available UTXOs and import the funds to [to].
+	// the available UTXOs and import the funds to [to]. If there are more
+	// UTXOs than the maximum number of inputs of a tx, the remaining UTXOs are
+	// left to be imported by subsequent txs.
 	//
 	// - [chainID] specifies the chain to be importing funds from.
 	// - [to] specifies where to send the imported funds to.
+	//
+	// By default, the tx fee is deducted from the imported funds. This can be
+	// changed with [common.WithFeeMode].
 	NewImportTx(
 		chainID ids.ID,
 		to *secp256k1fx.OutputOwners,
@@ -163,6 +223,9 @@ type Builder interface {
 	//
 	// - [chainID] specifies the chain to be exporting the funds to.
 	// - [outputs] specifies the outputs to send to the [chainID].
+	//
+	// By default, the tx fee is paid in addition to the [outputs]. This can be
+	// changed with [common.WithFeeMode].
 	NewExportTx(
 		chainID ids.ID,
 		outputs []*avax.TransferableOutput,
@@ -226,9 +289,11 @@ type Builder interface {
 	//   for this validator. Otherwise, this value should be the empty signer.
 	// - [assetID] specifies the asset to stake.
 	// - [validationRewardsOwner] specifies the owner of all the rewards this
-	//   validator earns for its validation period.
+	//   validator earns for its validation period. If nil, the default rewards
+	//   owner is used.
 	// - [delegationRewardsOwner] specifies the owner of all the rewards this
-	//   validator earns for delegations during its validation period.
+	//   validator earns for delegations during its validation period. If nil,
+	//   the default rewards owner is used.
 	// - [shares] specifies the fraction (out of 1,000,000) that this validator
 	//   will take from delegation rewards. If 1,000,000 is provided, 100% of
 	//   the delegation reward will be sent to the validator's [rewardsOwner].
@@ -310,6 +375,15 @@ func (b *builder) NewBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
+	ops := common.NewOptions(options)
+	if ops.FeeMode(common.FeeOnTop) == common.FeeFromAmount {
+		var err error
+		outputs, err = common.DeductFee(outputs, b.context.AVAXAssetID, b.context.BaseTxFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	toBurn := map[ids.ID]uint64{
 		b.context.AVAXAssetID: b.context.BaseTxFee,
 	}
@@ -323,7 +397,6 @@ func (b *builder) NewBaseTx(
 	}
 	toStake := map[ids.ID]uint64{}
 
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -341,6 +414,113 @@ func (b *builder) NewBaseTx(
 	return tx, b.initCtx(tx)
 }
 
+func (b *builder) NewConsolidationTx(
+	maxUTXOs int,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	ops := common.NewOptions(options)
+	utxos, err := b.getUTXOs(ops.Context(), constants.PlatformChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := ops.Addresses(b.addrs)
+	minIssuanceTime := ops.MinIssuanceTime()
+
+	addr, ok := addrs.Peek()
+	if !ok {
+		return nil, ErrNoChangeAddress
+	}
+	changeOwner := ops.ChangeOwner(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	})
+
+	inputs := make([]*avax.TransferableInput, 0, len(utxos))
+	for _, utxo := range utxos {
+		if utxo.AssetID() != b.context.AVAXAssetID {
+			continue
+		}
+
+		outIntf := utxo.Out
+		if lockedOut, ok := outIntf.(*stakeable.LockOut); ok {
+			if lockedOut.Locktime > minIssuanceTime {
+				// This output is currently locked, so this output can't be
+				// consolidated.
+				continue
+			}
+			outIntf = lockedOut.TransferableOut
+		}
+
+		out, ok := outIntf.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, ErrUnknownOutputType
+		}
+
+		inputSigIndices, ok := common.MatchOwners(&out.OutputOwners, addrs, minIssuanceTime)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		inputs = append(inputs, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt: out.Amt,
+				Input: secp256k1fx.Input{
+					SigIndices: inputSigIndices,
+				},
+			},
+		})
+	}
+
+	// Consume the smallest UTXOs first. The sort is stable so that UTXOs of
+	// equal value are consumed in the order of their IDs.
+	slices.SortStableFunc(inputs, func(a, b *avax.TransferableInput) int {
+		return cmp.Compare(a.In.Amount(), b.In.Amount())
+	})
+	if b.context.MaxInputs > 0 {
+		maxUTXOs = min(maxUTXOs, b.context.MaxInputs)
+	}
+	inputs = inputs[:min(maxUTXOs, len(inputs))]
+	if len(inputs) < 2 {
+		return nil, fmt.Errorf("%w: found %d spendable UTXOs", ErrTooFewUTXOs, len(inputs))
+	}
+
+	var consumed uint64
+	for _, in := range inputs {
+		consumed, err = math.Add64(consumed, in.In.Amount())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if consumed <= b.context.BaseTxFee {
+		// The consolidated output must hold a non-zero amount.
+		return nil, &InsufficientFundsError{
+			AssetID:   b.context.AVAXAssetID,
+			Required:  b.context.BaseTxFee + 1,
+			Available: consumed,
+		}
+	}
+
+	utils.Sort(inputs)
+	tx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    b.context.NetworkID,
+		BlockchainID: constants.PlatformChainID,
+		Ins:          inputs,
+		Outs: []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: b.context.AVAXAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          consumed - b.context.BaseTxFee,
+				OutputOwners: *changeOwner,
+			},
+		}},
+		Memo: ops.Memo(),
+	}}
+	return tx, b.initCtx(tx)
+}
+
 func (b *builder) NewAddValidatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -355,6 +535,10 @@ func (b *builder) NewAddValidatorTx(
 		avaxAssetID: vdr.Wght,
 	}
 	ops := common.NewOptions(options)
+	rewardsOwner = ops.RewardsOwner(rewardsOwner)
+	if rewardsOwner == nil {
+		return nil, ErrNoRewardsOwner
+	}
 	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -591,7 +775,7 @@ func (b *builder) NewImportTx(
 	options ...common.Option,
 ) (*txs.ImportTx, error) {
 	ops := common.NewOptions(options)
-	utxos, err := b.backend.UTXOs(ops.Context(), sourceChainID)
+	utxos, err := b.getUTXOs(ops.Context(), sourceChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -607,6 +791,11 @@ func (b *builder) NewImportTx(
 	)
 	// Iterate over the unlocked UTXOs
 	for _, utxo := range utxos {
+		if b.context.MaxInputs > 0 && len(importedInputs) >= b.context.MaxInputs {
+			// The remaining UTXOs must be imported in another tx
+			break
+		}
+
 		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
 		if !ok {
 			continue
@@ -650,9 +839,22 @@ func (b *builder) NewImportTx(
 		outputs      = make([]*avax.TransferableOutput, 0, len(importedAmounts))
 		importedAVAX = importedAmounts[avaxAssetID]
 	)
-	if importedAVAX > txFee {
+	switch {
+	case ops.FeeMode(common.FeeFromAmount) == common.FeeOnTop:
+		// The imported amount is left untouched and the tx fee is paid from
+		// this chain's UTXOs.
+		toBurn := map[ids.ID]uint64{
+			avaxAssetID: txFee,
+		}
+		toStake := map[ids.ID]uint64{}
+		var err error
+		inputs, outputs, _, err = b.spend(toBurn, toStake, ops)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+		}
+	case importedAVAX > txFee:
 		importedAmounts[avaxAssetID] -= txFee
-	} else {
+	default:
 		if importedAVAX < txFee { // imported amount goes toward paying tx fee
 			toBurn := map[ids.ID]uint64{
 				avaxAssetID: txFee - importedAVAX,
@@ -660,6 +862,13 @@ func (b *builder) NewImportTx(
 			toStake := map[ids.ID]uint64{}
 			var err error
 			inputs, outputs, _, err = b.spend(toBurn, toStake, ops)
+			var fundsErr *InsufficientFundsError
+			if errors.As(err, &fundsErr) {
+				// Report the funds needed to pay the whole fee, including the
+				// imported amount.
+				fundsErr.Required += importedAVAX
+				fundsErr.Available += importedAVAX
+			}
 			if err != nil {
 				return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 			}
@@ -677,6 +886,10 @@ func (b *builder) NewImportTx(
 		})
 	}
 
+	if err := b.verifyNumInputs(len(inputs) + len(importedInputs)); err != nil {
+		return nil, err
+	}
+
 	avax.SortTransferableOutputs(outputs, txs.Codec) // sort imported outputs
 	tx := &txs.ImportTx{
 		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
@@ -697,6 +910,15 @@ func (b *builder) NewExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
+	ops := common.NewOptions(options)
+	if ops.FeeMode(common.FeeOnTop) == common.FeeFromAmount {
+		var err error
+		outputs, err = common.DeductFee(outputs, b.context.AVAXAssetID, b.context.BaseTxFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	toBurn := map[ids.ID]uint64{
 		b.context.AVAXAssetID: b.context.BaseTxFee,
 	}
@@ -710,7 +932,6 @@ func (b *builder) NewExportTx(
 	}
 
 	toStake := map[ids.ID]uint64{}
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, _, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -811,6 +1032,11 @@ func (b *builder) NewAddPermissionlessValidatorTx(
 		assetID: vdr.Wght,
 	}
 	ops := common.NewOptions(options)
+	validationRewardsOwner = ops.RewardsOwner(validationRewardsOwner)
+	delegationRewardsOwner = ops.RewardsOwner(delegationRewardsOwner)
+	if validationRewardsOwner == nil || delegationRewardsOwner == nil {
+		return nil, ErrNoRewardsOwner
+	}
 	inputs, baseOutputs, stakeOutputs, err := b.spend(toBurn, toStake, ops)
 	if err != nil {
 		return nil, err
@@ -876,6 +1102,72 @@ func (b *builder) NewAddPermissionlessDelegatorTx(
 	return tx, b.initCtx(tx)
 }
 
+// getUTXOs returns the UTXOs on [chainID] sorted by their UTXO IDs. The backend
+// doesn't guarantee any ordering of the UTXOs, so sorting them ensures that
+// building a tx from the same UTXO set always selects the same UTXOs.
+func (b *builder) getUTXOs(ctx context.Context, chainID ids.ID) ([]*avax.UTXO, error) {
+	utxos, err := b.backend.UTXOs(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(utxos, func(a, b *avax.UTXO) int {
+		return a.UTXOID.Compare(&b.UTXOID)
+	})
+	return utxos, nil
+}
+
+// orderUTXOs returns [utxos] in the order they should be consumed by the
+// [selection] strategy. [amountsToBurn] and [amountsToStake] are the amounts
+// per asset that still need to be funded. The provided slice is not modified.
+func orderUTXOs(
+	utxos []*avax.UTXO,
+	selection common.UTXOSelection,
+	amountsToBurn map[ids.ID]uint64,
+	amountsToStake map[ids.ID]uint64,
+) []*avax.UTXO {
+	switch selection {
+	case common.LargestFirst:
+		utxos = slices.Clone(utxos)
+		slices.SortStableFunc(utxos, func(a, b *avax.UTXO) int {
+			return cmp.Compare(utxoAmount(b), utxoAmount(a))
+		})
+	case common.BestFit:
+		fits := func(utxo *avax.UTXO) bool {
+			assetID := utxo.AssetID()
+			needed, err := math.Add64(amountsToBurn[assetID], amountsToStake[assetID])
+			return err == nil && utxoAmount(utxo) >= needed
+		}
+
+		utxos = slices.Clone(utxos)
+		slices.SortStableFunc(utxos, func(a, b *avax.UTXO) int {
+			aFits, bFits := fits(a), fits(b)
+			switch {
+			case aFits && !bFits:
+				return -1
+			case !aFits && bFits:
+				return 1
+			case aFits:
+				// Prefer the smallest UTXO that covers the needed amount
+				return cmp.Compare(utxoAmount(a), utxoAmount(b))
+			default:
+				// Otherwise, prefer the largest UTXO
+				return cmp.Compare(utxoAmount(b), utxoAmount(a))
+			}
+		})
+	}
+	return utxos
+}
+
+// utxoAmount returns the amount held by [utxo], or 0 if its output doesn't
+// hold an amount.
+func utxoAmount(utxo *avax.UTXO) uint64 {
+	out, ok := utxo.Out.(avax.Amounter)
+	if !ok {
+		return 0
+	}
+	return out.Amount()
+}
+
 func (b *builder) getBalance(
 	chainID ids.ID,
 	options *common.Options,
@@ -935,6 +1227,9 @@ func (b *builder) getBalance(
 //     place into the staked outputs. First locked UTXOs are attempted to be
 //     used for these funds, and then unlocked UTXOs will be attempted to be
 //     used. There is no preferential ordering on the unlock times.
+//
+// The order in which UTXOs are consumed can be changed with
+// [common.WithUTXOSelection].
 func (b *builder) spend(
 	amountsToBurn map[ids.ID]uint64,
 	amountsToStake map[ids.ID]uint64,
@@ -945,7 +1240,7 @@ func (b *builder) spend(
 	stakeOutputs []*avax.TransferableOutput,
 	err error,
 ) {
-	utxos, err := b.backend.UTXOs(options.Context(), constants.PlatformChainID)
+	utxos, err := b.getUTXOs(options.Context(), constants.PlatformChainID)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -969,8 +1264,23 @@ func (b *builder) spend(
 	changeOutputs = make([]*avax.TransferableOutput, 0)
 	stakeOutputs = make([]*avax.TransferableOutput, 0)
 
+	// Record the requested amounts to report them if the UTXOs are
+	// insufficient.
+	requiredAmounts := make(map[ids.ID]uint64, len(amountsToBurn)+len(amountsToStake))
+	for assetID, amount := range amountsToBurn {
+		requiredAmounts[assetID] = amount
+	}
+	for assetID, amount := range amountsToStake {
+		requiredAmounts[assetID], err = math.Add64(requiredAmounts[assetID], amount)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	selection := options.UTXOSelection()
+
 	// Iterate over the locked UTXOs
-	for _, utxo := range utxos {
+	for _, utxo := range orderUTXOs(utxos, selection, nil, amountsToStake) {
 		assetID := utxo.AssetID()
 		remainingAmountToStake := amountsToStake[assetID]
 
@@ -1053,7 +1363,7 @@ func (b *builder) spend(
 	}
 
 	// Iterate over the unlocked UTXOs
-	for _, utxo := range utxos {
+	for _, utxo := range orderUTXOs(utxos, selection, amountsToBurn, amountsToStake) {
 		assetID := utxo.AssetID()
 		remainingAmountToStake := amountsToStake[assetID]
 		remainingAmountToBurn := amountsToBurn[assetID]
@@ -1132,25 +1442,21 @@ func (b *builder) spend(
 		}
 	}
 
-	for assetID, amount := range amountsToStake {
-		if amount != 0 {
-			return nil, nil, nil, fmt.Errorf(
-				"%w: provided UTXOs need %d more units of asset %q to stake",
-				ErrInsufficientFunds,
-				amount,
-				assetID,
-			)
+	for assetID, required := range requiredAmounts {
+		// The remaining amounts sum to at most [required], so this can't
+		// overflow.
+		shortfall := amountsToBurn[assetID] + amountsToStake[assetID]
+		if shortfall != 0 {
+			return nil, nil, nil, &InsufficientFundsError{
+				AssetID:   assetID,
+				Required:  required,
+				Available: required - shortfall,
+			}
 		}
 	}
-	for assetID, amount := range amountsToBurn {
-		if amount != 0 {
-			return nil, nil, nil, fmt.Errorf(
-				"%w: provided UTXOs need %d more units of asset %q",
-				ErrInsufficientFunds,
-				amount,
-				assetID,
-			)
-		}
+
+	if err := b.verifyNumInputs(len(inputs)); err != nil {
+		return nil, nil, nil, err
 	}
 
 	utils.Sort(inputs)                                     // sort inputs
@@ -1159,6 +1465,15 @@ func (b *builder) spend(
 	return inputs, changeOutputs, stakeOutputs, nil
 }
 
+// verifyNumInputs returns an error if a tx consuming [numInputs] inputs would
+// be rejected by the P-chain.
+func (b *builder) verifyNumInputs(numInputs int) error {
+	if b.context.MaxInputs > 0 && numInputs > b.context.MaxInputs {
+		return fmt.Errorf("%w: %d > %d", ErrTooManyInputs, numInputs, b.context.MaxInputs)
+	}
+	return nil
+}
+
 func (b *builder) authorizeSubnet(subnetID ids.ID, options *common.Options) (*secp256k1fx.Input, error) {
 	ownerIntf, err := b.backend.GetSubnetOwner(options.Context(), subnetID)
 	if err != nil {
@@ -67,6 +67,16 @@ func (b *builderWithOptions) NewBaseTx(
 	)
 }
 
+func (b *builderWithOptions) NewConsolidationTx(
+	maxUTXOs int,
+	options ...common.Option,
+) (*txs.BaseTx, error) {
+	return b.builder.NewConsolidationTx(
+		maxUTXOs,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
 func (b *builderWithOptions) NewAddValidatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
@@ -4,6 +4,8 @@
 package p
 
 import (
+	"context"
+	"slices"
 	"testing"
 	"time"
 
@@ -94,6 +96,256 @@ func TestBaseTx(t *testing.T) {
 	require.Equal(outputsToMove[0], outs[1])
 }
 
+func TestBaseTxDeterministicUTXOSelection(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey = testKeys[1]
+		utxos    = makeTestUTXOs(utxosKey)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+
+		// data to build the transaction
+		outputsToMove = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: units.MilliAvax,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	// The UTXOs are stored in a map, so they are returned by the backend in a
+	// random order. The built tx should not depend on that order.
+	var expectedTx *txs.BaseTx
+	for i := 0; i < 10; i++ {
+		globalUTXOs := common.NewUTXOs()
+		for _, utxo := range utxos {
+			require.NoError(globalUTXOs.AddUTXO(
+				context.Background(),
+				constants.PlatformChainID,
+				constants.PlatformChainID,
+				utxo,
+			))
+		}
+		chainUTXOs := common.NewChainUTXOs(constants.PlatformChainID, globalUTXOs)
+		backend := NewBackend(testContext, chainUTXOs, nil)
+		txBuilder := builder.New(set.Of(utxoAddr), testContext, backend)
+
+		utx, err := txBuilder.NewBaseTx(outputsToMove)
+		require.NoError(err)
+		if expectedTx == nil {
+			expectedTx = utx
+			continue
+		}
+		require.Equal(expectedTx, utx)
+	}
+}
+
+func TestBaseTxUTXOSelection(t *testing.T) {
+	var (
+		utxosKey = testKeys[1]
+		utxoAddr = utxosKey.Address()
+		owner    = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+
+		amounts = []uint64{
+			units.Avax,
+			3 * units.Avax,
+			5 * units.Avax,
+			10 * units.Avax,
+		}
+		utxos = make([]*avax.UTXO, len(amounts))
+	)
+	for i, amount := range amounts {
+		utxos[i] = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID: ids.Empty.Prefix(uint64(i)),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: owner,
+			},
+		}
+	}
+
+	// [common.DefaultUTXOSelection] consumes the UTXOs in the order of their
+	// IDs.
+	utxosByID := slices.Clone(utxos)
+	slices.SortFunc(utxosByID, func(a, b *avax.UTXO) int {
+		return a.UTXOID.Compare(&b.UTXOID)
+	})
+	var (
+		defaultAmounts []uint64
+		defaultTotal   uint64
+	)
+	for _, utxo := range utxosByID {
+		if defaultTotal >= 4*units.Avax+testContext.BaseTxFee {
+			break
+		}
+		amount := utxo.Out.(*secp256k1fx.TransferOutput).Amt
+		defaultAmounts = append(defaultAmounts, amount)
+		defaultTotal += amount
+	}
+
+	tests := []struct {
+		name            string
+		selection       common.UTXOSelection
+		amount          uint64
+		expectedAmounts []uint64
+	}{
+		{
+			name:            "default",
+			selection:       common.DefaultUTXOSelection,
+			amount:          4 * units.Avax,
+			expectedAmounts: defaultAmounts,
+		},
+		{
+			name:            "largest first",
+			selection:       common.LargestFirst,
+			amount:          4 * units.Avax,
+			expectedAmounts: []uint64{10 * units.Avax},
+		},
+		{
+			name:            "best fit single utxo",
+			selection:       common.BestFit,
+			amount:          4 * units.Avax,
+			expectedAmounts: []uint64{5 * units.Avax},
+		},
+		{
+			name:            "best fit exact amount",
+			selection:       common.BestFit,
+			amount:          3*units.Avax - testContext.BaseTxFee,
+			expectedAmounts: []uint64{3 * units.Avax},
+		},
+		{
+			name:            "best fit multiple utxos",
+			selection:       common.BestFit,
+			amount:          12 * units.Avax,
+			expectedAmounts: []uint64{5 * units.Avax, 10 * units.Avax},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			chainUTXOs := common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+				constants.PlatformChainID: utxos,
+			})
+			backend := NewBackend(testContext, chainUTXOs, nil)
+			txBuilder := builder.New(set.Of(utxoAddr), testContext, backend)
+
+			utx, err := txBuilder.NewBaseTx(
+				[]*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: avaxAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          test.amount,
+						OutputOwners: owner,
+					},
+				}},
+				common.WithUTXOSelection(test.selection),
+			)
+			require.NoError(err)
+
+			consumedAmounts := make([]uint64, len(utx.Ins))
+			for i, in := range utx.Ins {
+				consumedAmounts[i] = in.In.Amount()
+			}
+			require.ElementsMatch(test.expectedAmounts, consumedAmounts)
+		})
+	}
+}
+
+func TestBaseTxInsufficientFunds(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey = testKeys[1]
+		utxoAddr = utxosKey.Address()
+		owner    = secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+		utxos = []*avax.UTXO{{
+			UTXOID: avax.UTXOID{
+				TxID: ids.Empty.Prefix(1),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          units.Avax,
+				OutputOwners: owner,
+			},
+		}}
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+	)
+
+	_, err := txBuilder.NewBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          2 * units.Avax,
+			OutputOwners: owner,
+		},
+	}})
+	require.ErrorIs(err, builder.ErrInsufficientFunds)
+
+	var fundsErr *builder.InsufficientFundsError
+	require.ErrorAs(err, &fundsErr)
+	require.Equal(avaxAssetID, fundsErr.AssetID)
+	require.Equal(2*units.Avax+testContext.BaseTxFee, fundsErr.Required)
+	require.Equal(units.Avax, fundsErr.Available)
+	require.Equal(units.Avax+testContext.BaseTxFee, fundsErr.Shortfall())
+}
+
+func TestConsolidationTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		utxoAddr  = utxosKey.Address()
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+	)
+
+	utx, err := txBuilder.NewConsolidationTx(len(utxos))
+	require.NoError(err)
+
+	// only the unlocked AVAX UTXOs are consolidated
+	ins := utx.Ins
+	outs := utx.Outs
+	require.Len(ins, 2)
+	require.Len(outs, 1)
+
+	consumed := ins[0].In.Amount() + ins[1].In.Amount()
+	require.Equal(consumed-testContext.BaseTxFee, outs[0].Out.Amount())
+	require.Equal(avaxAssetID, outs[0].AssetID())
+
+	// consolidating a single UTXO doesn't reduce the number of UTXOs
+	_, err = txBuilder.NewConsolidationTx(1)
+	require.ErrorIs(err, builder.ErrTooFewUTXOs)
+}
+
 func TestAddSubnetValidatorTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -410,6 +662,104 @@ func TestImportTx(t *testing.T) {
 	require.Equal(expectedConsumed, consumed)
 }
 
+func TestImportTxInsufficientFunds(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey      = testKeys[1]
+		utxoAddr      = utxosKey.Address()
+		sourceChainID = ids.GenerateTestID()
+		importedUTXOs = []*avax.UTXO{{
+			UTXOID: avax.UTXOID{
+				TxID: ids.Empty.Prefix(1),
+			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: testContext.BaseTxFee - 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			sourceChainID: importedUTXOs,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		importTo = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				testKeys[0].Address(),
+			},
+		}
+	)
+
+	_, err := txBuilder.NewImportTx(sourceChainID, importTo)
+	require.ErrorIs(err, builder.ErrInsufficientFunds)
+
+	// the imported amount counts towards the fee
+	var fundsErr *builder.InsufficientFundsError
+	require.ErrorAs(err, &fundsErr)
+	require.Equal(avaxAssetID, fundsErr.AssetID)
+	require.Equal(testContext.BaseTxFee, fundsErr.Required)
+	require.Equal(testContext.BaseTxFee-1, fundsErr.Available)
+	require.Equal(uint64(1), fundsErr.Shortfall())
+}
+
+func TestImportTxMaxInputs(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey      = testKeys[1]
+		utxos         = makeTestUTXOs(utxosKey)
+		sourceChainID = ids.GenerateTestID()
+		importedUTXOs = []*avax.UTXO{utxos[0], utxos[4]}
+		chainUTXOs    = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+			sourceChainID:             importedUTXOs,
+		})
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		importTo = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				testKeys[0].Address(),
+			},
+		}
+	)
+
+	maxInputsContext := *testContext
+	maxInputsContext.MaxInputs = len(importedUTXOs) - 1
+	backend := NewBackend(&maxInputsContext, chainUTXOs, nil)
+	txBuilder := builder.New(set.Of(utxoAddr), &maxInputsContext, backend)
+
+	// the UTXOs that don't fit are left to be imported by another tx
+	utx, err := txBuilder.NewImportTx(
+		sourceChainID,
+		importTo,
+	)
+	require.NoError(err)
+	require.Len(utx.ImportedInputs, maxInputsContext.MaxInputs)
+
+	// spending more UTXOs than allowed fails
+	_, err = txBuilder.NewBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          9 * units.Avax,
+			OutputOwners: *importTo,
+		},
+	}})
+	require.ErrorIs(err, builder.ErrTooManyInputs)
+}
+
 func TestExportTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -459,6 +809,135 @@ func TestExportTx(t *testing.T) {
 	require.Equal(utx.ExportedOutputs, exportedOutputs)
 }
 
+func TestImportTxFeeOnTop(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey      = testKeys[1]
+		utxos         = makeTestUTXOs(utxosKey)
+		sourceChainID = ids.GenerateTestID()
+		importedUTXOs = utxos[:1]
+		chainUTXOs    = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+			sourceChainID:             importedUTXOs,
+		})
+
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		importKey = testKeys[0]
+		importTo  = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				importKey.Address(),
+			},
+		}
+	)
+
+	// build the transaction
+	utx, err := builder.NewImportTx(
+		sourceChainID,
+		importTo,
+		common.WithFeeMode(common.FeeOnTop),
+	)
+	require.NoError(err)
+
+	// the whole imported amount is sent to [importTo] and the fee is paid from
+	// the P-chain UTXOs
+	ins := utx.Ins
+	outs := utx.Outs
+	importedIns := utx.ImportedInputs
+	require.Len(ins, 1)
+	require.Len(importedIns, 1)
+	require.Len(outs, 2)
+
+	var (
+		importedAmount = importedIns[0].In.Amount()
+		consumed       = ins[0].In.Amount() + importedAmount
+		foundImported  bool
+	)
+	for _, out := range outs {
+		consumed -= out.Out.Amount()
+
+		transferOut := out.Out.(*secp256k1fx.TransferOutput)
+		if transferOut.OutputOwners.Equals(importTo) {
+			require.Equal(importedAmount, transferOut.Amt)
+			foundImported = true
+		}
+	}
+	require.True(foundImported)
+	require.Equal(testContext.BaseTxFee, consumed)
+}
+
+func TestExportTxFeeFromAmount(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		subnetID        = ids.GenerateTestID()
+		exportedAmount  = 7 * units.Avax
+		exportedOutputs = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: exportedAmount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	// build the transaction
+	utx, err := builder.NewExportTx(
+		subnetID,
+		exportedOutputs,
+		common.WithFeeMode(common.FeeFromAmount),
+	)
+	require.NoError(err)
+
+	// check UTXOs selection and fee financing
+	ins := utx.Ins
+	outs := utx.Outs
+	require.Len(ins, 2)
+	require.Len(outs, 1)
+	require.Len(utx.ExportedOutputs, 1)
+
+	// the fee is deducted from the exported amount
+	require.Equal(exportedAmount-testContext.BaseTxFee, utx.ExportedOutputs[0].Out.Amount())
+	consumed := ins[0].In.Amount() + ins[1].In.Amount() - outs[0].Out.Amount()
+	require.Equal(exportedAmount, consumed)
+
+	// the provided outputs must not be modified
+	require.Equal(exportedAmount, exportedOutputs[0].Out.Amount())
+
+	// the fee can't consume the whole exported amount
+	exportedOutputs[0].Out.(*secp256k1fx.TransferOutput).Amt = testContext.BaseTxFee
+	_, err = builder.NewExportTx(
+		subnetID,
+		exportedOutputs,
+		common.WithFeeMode(common.FeeFromAmount),
+	)
+	require.ErrorIs(err, common.ErrFeeExceedsAmount)
+}
+
 func TestTransformSubnetTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -599,6 +1078,74 @@ func TestAddPermissionlessValidatorTx(t *testing.T) {
 	require.Equal(expectedConsumed, consumed)
 }
 
+func TestAddPermissionlessValidatorTxDefaultRewardsOwner(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder
+		utxoAddr  = utxosKey.Address()
+		txBuilder = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		defaultRewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				testKeys[0].Address(),
+			},
+		}
+		delegationRewardsOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				testKeys[2].Address(),
+			},
+		}
+		vdr = &txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    uint64(time.Now().Add(time.Hour).Unix()),
+				Wght:   2 * units.Avax,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		}
+	)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	// Without a default, a rewards owner must be given.
+	_, err = txBuilder.NewAddPermissionlessValidatorTx(
+		vdr,
+		signer.NewProofOfPossession(sk),
+		avaxAssetID,
+		nil,
+		delegationRewardsOwner,
+		reward.PercentDenominator,
+	)
+	require.ErrorIs(err, builder.ErrNoRewardsOwner)
+
+	// The default only replaces the rewards owners that aren't given.
+	utx, err := txBuilder.NewAddPermissionlessValidatorTx(
+		vdr,
+		signer.NewProofOfPossession(sk),
+		avaxAssetID,
+		nil,
+		delegationRewardsOwner,
+		reward.PercentDenominator,
+		common.WithDefaultRewardsOwner(defaultRewardsOwner),
+	)
+	require.NoError(err)
+	require.Equal(defaultRewardsOwner, utx.ValidatorRewardsOwner)
+	require.Equal(delegationRewardsOwner, utx.DelegatorRewardsOwner)
+}
+
 func TestAddPermissionlessDelegatorTx(t *testing.T) {
 	var (
 		require = require.New(t)
@@ -0,0 +1,390 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	vmsigner "github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	walletsigner "github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+)
+
+var _ SignedBuilder = (*signedBuilder)(nil)
+
+// SignedBuilder creates and signs transactions without issuing them. It only
+// depends on the provided builder and signer, so it can be used to construct
+// transactions without access to a running node.
+//
+// The arguments of every method are documented on the corresponding method of
+// [builder.Builder].
+type SignedBuilder interface {
+	// NewBaseTx creates and signs a new simple value transfer.
+	NewBaseTx(
+		outputs []*avax.TransferableOutput,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewConsolidationTx creates and signs a new simple value transfer that
+	// merges the smallest unlocked AVAX UTXOs into a single output.
+	NewConsolidationTx(
+		maxUTXOs int,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewAddValidatorTx creates and signs a new validator of the primary network.
+	NewAddValidatorTx(
+		vdr *txs.Validator,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		shares uint32,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewAddSubnetValidatorTx creates and signs a new validator of a subnet.
+	NewAddSubnetValidatorTx(
+		vdr *txs.SubnetValidator,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewRemoveSubnetValidatorTx creates and signs a transaction that removes a validator of a
+	// subnet.
+	NewRemoveSubnetValidatorTx(
+		nodeID ids.NodeID,
+		subnetID ids.ID,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewAddDelegatorTx creates and signs a new delegator to a validator on the
+	// primary network.
+	NewAddDelegatorTx(
+		vdr *txs.Validator,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewCreateChainTx creates and signs a new chain in the named subnet.
+	NewCreateChainTx(
+		subnetID ids.ID,
+		genesis []byte,
+		vmID ids.ID,
+		fxIDs []ids.ID,
+		chainName string,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewCreateSubnetTx creates and signs a new subnet with the specified owner.
+	NewCreateSubnetTx(
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewTransferSubnetOwnershipTx creates and signs a transaction that changes the owner of
+	// the named subnet.
+	NewTransferSubnetOwnershipTx(
+		subnetID ids.ID,
+		owner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewImportTx creates and signs an import transaction.
+	NewImportTx(
+		chainID ids.ID,
+		to *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewExportTx creates and signs an export transaction.
+	NewExportTx(
+		chainID ids.ID,
+		outputs []*avax.TransferableOutput,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewTransformSubnetTx creates and signs a transform subnet transaction.
+	NewTransformSubnetTx(
+		subnetID ids.ID,
+		assetID ids.ID,
+		initialSupply uint64,
+		maxSupply uint64,
+		minConsumptionRate uint64,
+		maxConsumptionRate uint64,
+		minValidatorStake uint64,
+		maxValidatorStake uint64,
+		minStakeDuration time.Duration,
+		maxStakeDuration time.Duration,
+		minDelegationFee uint32,
+		minDelegatorStake uint64,
+		maxValidatorWeightFactor byte,
+		uptimeRequirement uint32,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewAddPermissionlessValidatorTx creates and signs a new validator of the specified
+	// subnet.
+	NewAddPermissionlessValidatorTx(
+		vdr *txs.SubnetValidator,
+		signer vmsigner.Signer,
+		assetID ids.ID,
+		validationRewardsOwner *secp256k1fx.OutputOwners,
+		delegationRewardsOwner *secp256k1fx.OutputOwners,
+		shares uint32,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
+	// NewAddPermissionlessDelegatorTx creates and signs a new delegator of the specified
+	// subnet.
+	NewAddPermissionlessDelegatorTx(
+		vdr *txs.SubnetValidator,
+		assetID ids.ID,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.Tx, error)
+}
+
+// NewSignedBuilder returns a SignedBuilder that builds transactions with
+// [builder] and signs them with [signer].
+func NewSignedBuilder(
+	builder builder.Builder,
+	signer walletsigner.Signer,
+) SignedBuilder {
+	return &signedBuilder{
+		builder: builder,
+		signer:  signer,
+	}
+}
+
+type signedBuilder struct {
+	builder builder.Builder
+	signer  walletsigner.Signer
+}
+
+func (b *signedBuilder) NewBaseTx(
+	outputs []*avax.TransferableOutput,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewBaseTx(outputs, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewConsolidationTx(
+	maxUTXOs int,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewConsolidationTx(maxUTXOs, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewAddValidatorTx(
+	vdr *txs.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewAddValidatorTx(vdr, rewardsOwner, shares, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewAddSubnetValidatorTx(
+	vdr *txs.SubnetValidator,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewAddSubnetValidatorTx(vdr, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewRemoveSubnetValidatorTx(
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewRemoveSubnetValidatorTx(nodeID, subnetID, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewAddDelegatorTx(
+	vdr *txs.Validator,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewAddDelegatorTx(vdr, rewardsOwner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewCreateChainTx(
+	subnetID ids.ID,
+	genesis []byte,
+	vmID ids.ID,
+	fxIDs []ids.ID,
+	chainName string,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewCreateChainTx(
+		subnetID,
+		genesis,
+		vmID,
+		fxIDs,
+		chainName,
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewCreateSubnetTx(
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewCreateSubnetTx(owner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	owner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewTransferSubnetOwnershipTx(subnetID, owner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewImportTx(
+	chainID ids.ID,
+	to *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewImportTx(chainID, to, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewExportTx(
+	chainID ids.ID,
+	outputs []*avax.TransferableOutput,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewExportTx(chainID, outputs, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewTransformSubnetTx(
+	subnetID ids.ID,
+	assetID ids.ID,
+	initialSupply uint64,
+	maxSupply uint64,
+	minConsumptionRate uint64,
+	maxConsumptionRate uint64,
+	minValidatorStake uint64,
+	maxValidatorStake uint64,
+	minStakeDuration time.Duration,
+	maxStakeDuration time.Duration,
+	minDelegationFee uint32,
+	minDelegatorStake uint64,
+	maxValidatorWeightFactor byte,
+	uptimeRequirement uint32,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewTransformSubnetTx(
+		subnetID,
+		assetID,
+		initialSupply,
+		maxSupply,
+		minConsumptionRate,
+		maxConsumptionRate,
+		minValidatorStake,
+		maxValidatorStake,
+		minStakeDuration,
+		maxStakeDuration,
+		minDelegationFee,
+		minDelegatorStake,
+		maxValidatorWeightFactor,
+		uptimeRequirement,
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewAddPermissionlessValidatorTx(
+	vdr *txs.SubnetValidator,
+	signer vmsigner.Signer,
+	assetID ids.ID,
+	validationRewardsOwner *secp256k1fx.OutputOwners,
+	delegationRewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewAddPermissionlessValidatorTx(
+		vdr,
+		signer,
+		assetID,
+		validationRewardsOwner,
+		delegationRewardsOwner,
+		shares,
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) NewAddPermissionlessDelegatorTx(
+	vdr *txs.SubnetValidator,
+	assetID ids.ID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := b.builder.NewAddPermissionlessDelegatorTx(vdr, assetID, rewardsOwner, options...)
+	if err != nil {
+		return nil, err
+	}
+	return b.sign(utx, options...)
+}
+
+func (b *signedBuilder) sign(
+	utx txs.UnsignedTx,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	ops := common.NewOptions(options)
+	return walletsigner.SignUnsigned(ops.Context(), b.signer, utx)
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+func TestSignedBuilderAddSubnetValidatorTx(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+
+		subnetID       = ids.GenerateTestID()
+		subnetAuthKey  = testKeys[0]
+		subnetAuthAddr = subnetAuthKey.Address()
+		subnetOwner    = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{subnetAuthAddr},
+		}
+		subnets = map[ids.ID]*txs.Tx{
+			subnetID: {
+				Unsigned: &txs.CreateSubnetTx{
+					Owner: subnetOwner,
+				},
+			},
+		}
+
+		backend = NewBackend(testContext, chainUTXOs, subnets)
+
+		// builder
+		utxoAddr      = utxosKey.Address()
+		kc            = secp256k1fx.NewKeychain(utxosKey, subnetAuthKey)
+		signedBuilder = NewSignedBuilder(
+			builder.New(set.Of(utxoAddr, subnetAuthAddr), testContext, backend),
+			signer.New(kc, backend),
+		)
+
+		// data to build the transaction
+		subnetValidator = &txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: ids.GenerateTestNodeID(),
+				End:    uint64(time.Now().Add(time.Hour).Unix()),
+			},
+			Subnet: subnetID,
+		}
+	)
+
+	tx, err := signedBuilder.NewAddSubnetValidatorTx(subnetValidator)
+	require.NoError(err)
+
+	// The signed tx must round trip through the codec
+	parsedTx, err := txs.Parse(txs.Codec, tx.Bytes())
+	require.NoError(err)
+	require.Equal(tx.ID(), parsedTx.ID())
+
+	// Every input and the subnet auth must be signed by the expected key
+	utx := tx.Unsigned.(*txs.AddSubnetValidatorTx)
+	require.Len(tx.Creds, len(utx.Ins)+1)
+
+	unsignedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &tx.Unsigned)
+	require.NoError(err)
+	for i, credIntf := range tx.Creds {
+		cred := credIntf.(*secp256k1fx.Credential)
+		require.Len(cred.Sigs, 1)
+
+		expectedAddr := utxoAddr
+		if i == len(utx.Ins) {
+			expectedAddr = subnetAuthAddr
+		}
+
+		pk, err := secp256k1.RecoverPublicKey(unsignedBytes, cred.Sigs[0][:])
+		require.NoError(err)
+		require.Equal(expectedAddr, pk.Address())
+	}
+}
+
+func TestSignedBuilderPartiallySigns(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey   = testKeys[1]
+		utxos      = makeTestUTXOs(utxosKey)
+		chainUTXOs = common.NewDeterministicChainUTXOs(require, map[ids.ID][]*avax.UTXO{
+			constants.PlatformChainID: utxos,
+		})
+		backend = NewBackend(testContext, chainUTXOs, nil)
+
+		// builder without access to the key that owns the UTXOs
+		utxoAddr      = utxosKey.Address()
+		kc            = secp256k1fx.NewKeychain(testKeys[0])
+		signedBuilder = NewSignedBuilder(
+			builder.New(set.Of(utxoAddr), testContext, backend),
+			signer.New(kc, backend),
+		)
+
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{utxoAddr},
+		}
+	)
+
+	tx, err := signedBuilder.NewCreateSubnetTx(owner)
+	require.NoError(err)
+
+	// Signatures that couldn't be provided are left empty
+	utx := tx.Unsigned.(*txs.CreateSubnetTx)
+	require.Len(tx.Creds, len(utx.Ins))
+	for _, credIntf := range tx.Creds {
+		cred := credIntf.(*secp256k1fx.Credential)
+		require.Len(cred.Sigs, 1)
+		require.Equal([secp256k1.SignatureLen]byte{}, cred.Sigs[0])
+	}
+}
@@ -43,6 +43,16 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueConsolidationTx creates, signs, and issues a new simple value
+	// transfer that merges the smallest unlocked AVAX UTXOs into a single
+	// output.
+	//
+	// - [maxUTXOs] specifies the maximum number of UTXOs to consume.
+	IssueConsolidationTx(
+		maxUTXOs int,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueAddValidatorTx creates, signs, and issues a new validator of the
 	// primary network.
 	//
@@ -263,18 +273,20 @@ func NewWallet(
 	backend Backend,
 ) Wallet {
 	return &wallet{
-		Backend: backend,
-		builder: builder,
-		signer:  signer,
-		client:  client,
+		Backend:       backend,
+		builder:       builder,
+		signer:        signer,
+		signedBuilder: NewSignedBuilder(builder, signer),
+		client:        client,
 	}
 }
 
 type wallet struct {
 	Backend
-	builder builder.Builder
-	signer  walletsigner.Signer
-	client  platformvm.Client
+	builder       builder.Builder
+	signer        walletsigner.Signer
+	signedBuilder SignedBuilder
+	client        platformvm.Client
 }
 
 func (w *wallet) Builder() builder.Builder {
@@ -289,11 +301,22 @@ func (w *wallet) IssueBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewBaseTx(outputs, options...)
+	tx, err := w.signedBuilder.NewBaseTx(outputs, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
+}
+
+func (w *wallet) IssueConsolidationTx(
+	maxUTXOs int,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	tx, err := w.signedBuilder.NewConsolidationTx(maxUTXOs, options...)
+	if err != nil {
+		return nil, err
+	}
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueAddValidatorTx(
@@ -302,22 +325,22 @@ func (w *wallet) IssueAddValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddValidatorTx(vdr, rewardsOwner, shares, options...)
+	tx, err := w.signedBuilder.NewAddValidatorTx(vdr, rewardsOwner, shares, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueAddSubnetValidatorTx(
 	vdr *txs.SubnetValidator,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddSubnetValidatorTx(vdr, options...)
+	tx, err := w.signedBuilder.NewAddSubnetValidatorTx(vdr, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueRemoveSubnetValidatorTx(
@@ -325,11 +348,11 @@ func (w *wallet) IssueRemoveSubnetValidatorTx(
 	subnetID ids.ID,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewRemoveSubnetValidatorTx(nodeID, subnetID, options...)
+	tx, err := w.signedBuilder.NewRemoveSubnetValidatorTx(nodeID, subnetID, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueAddDelegatorTx(
@@ -337,11 +360,11 @@ func (w *wallet) IssueAddDelegatorTx(
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddDelegatorTx(vdr, rewardsOwner, options...)
+	tx, err := w.signedBuilder.NewAddDelegatorTx(vdr, rewardsOwner, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueCreateChainTx(
@@ -352,22 +375,22 @@ func (w *wallet) IssueCreateChainTx(
 	chainName string,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewCreateChainTx(subnetID, genesis, vmID, fxIDs, chainName, options...)
+	tx, err := w.signedBuilder.NewCreateChainTx(subnetID, genesis, vmID, fxIDs, chainName, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueCreateSubnetTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewCreateSubnetTx(owner, options...)
+	tx, err := w.signedBuilder.NewCreateSubnetTx(owner, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueTransferSubnetOwnershipTx(
@@ -375,11 +398,11 @@ func (w *wallet) IssueTransferSubnetOwnershipTx(
 	owner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewTransferSubnetOwnershipTx(subnetID, owner, options...)
+	tx, err := w.signedBuilder.NewTransferSubnetOwnershipTx(subnetID, owner, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueImportTx(
@@ -387,11 +410,11 @@ func (w *wallet) IssueImportTx(
 	to *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewImportTx(sourceChainID, to, options...)
+	tx, err := w.signedBuilder.NewImportTx(sourceChainID, to, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueExportTx(
@@ -399,11 +422,11 @@ func (w *wallet) IssueExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewExportTx(chainID, outputs, options...)
+	tx, err := w.signedBuilder.NewExportTx(chainID, outputs, options...)
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueTransformSubnetTx(
@@ -423,7 +446,7 @@ func (w *wallet) IssueTransformSubnetTx(
 	uptimeRequirement uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewTransformSubnetTx(
+	tx, err := w.signedBuilder.NewTransformSubnetTx(
 		subnetID,
 		assetID,
 		initialSupply,
@@ -443,7 +466,7 @@ func (w *wallet) IssueTransformSubnetTx(
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueAddPermissionlessValidatorTx(
@@ -455,7 +478,7 @@ func (w *wallet) IssueAddPermissionlessValidatorTx(
 	shares uint32,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddPermissionlessValidatorTx(
+	tx, err := w.signedBuilder.NewAddPermissionlessValidatorTx(
 		vdr,
 		signer,
 		assetID,
@@ -467,7 +490,7 @@ func (w *wallet) IssueAddPermissionlessValidatorTx(
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueAddPermissionlessDelegatorTx(
@@ -476,7 +499,7 @@ func (w *wallet) IssueAddPermissionlessDelegatorTx(
 	rewardsOwner *secp256k1fx.OutputOwners,
 	options ...common.Option,
 ) (*txs.Tx, error) {
-	utx, err := w.builder.NewAddPermissionlessDelegatorTx(
+	tx, err := w.signedBuilder.NewAddPermissionlessDelegatorTx(
 		vdr,
 		assetID,
 		rewardsOwner,
@@ -485,7 +508,7 @@ func (w *wallet) IssueAddPermissionlessDelegatorTx(
 	if err != nil {
 		return nil, err
 	}
-	return w.IssueUnsignedTx(utx, options...)
+	return tx, w.IssueTx(tx, options...)
 }
 
 func (w *wallet) IssueUnsignedTx(
@@ -55,6 +55,16 @@ func (w *walletWithOptions) IssueBaseTx(
 	)
 }
 
+func (w *walletWithOptions) IssueConsolidationTx(
+	maxUTXOs int,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueConsolidationTx(
+		maxUTXOs,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *walletWithOptions) IssueAddValidatorTx(
 	vdr *txs.Validator,
 	rewardsOwner *secp256k1fx.OutputOwners,
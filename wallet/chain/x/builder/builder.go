@@ -60,6 +60,9 @@ type Builder interface {
 	//
 	// - [outputs] specifies all the recipients and amounts that should be sent
 	//   from this transaction.
+	//
+	// By default, the tx fee is paid in addition to the [outputs]. This can be
+	// changed with [common.WithFeeMode].
 	NewBaseTx(
 		outputs []*avax.TransferableOutput,
 		options ...common.Option,
@@ -139,6 +142,9 @@ type Builder interface {
 	//
 	// - [chainID] specifies the chain to be importing funds from.
 	// - [to] specifies where to send the imported funds to.
+	//
+	// By default, the tx fee is deducted from the imported funds. This can be
+	// changed with [common.WithFeeMode].
 	NewImportTx(
 		chainID ids.ID,
 		to *secp256k1fx.OutputOwners,
@@ -150,6 +156,9 @@ type Builder interface {
 	//
 	// - [chainID] specifies the chain to be exporting the funds to.
 	// - [outputs] specifies the outputs to send to the [chainID].
+	//
+	// By default, the tx fee is paid in addition to the [outputs]. This can be
+	// changed with [common.WithFeeMode].
 	NewExportTx(
 		chainID ids.ID,
 		outputs []*avax.TransferableOutput,
@@ -208,6 +217,15 @@ func (b *builder) NewBaseTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
+	ops := common.NewOptions(options)
+	if ops.FeeMode(common.FeeOnTop) == common.FeeFromAmount {
+		var err error
+		outputs, err = common.DeductFee(outputs, b.context.AVAXAssetID, b.context.BaseTxFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	toBurn := map[ids.ID]uint64{
 		b.context.AVAXAssetID: b.context.BaseTxFee,
 	}
@@ -220,7 +238,6 @@ func (b *builder) NewBaseTx(
 		toBurn[assetID] = amountToBurn
 	}
 
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -428,9 +445,21 @@ func (b *builder) NewImportTx(
 		outputs      = make([]*avax.TransferableOutput, 0, len(importedAmounts))
 		importedAVAX = importedAmounts[avaxAssetID]
 	)
-	if importedAVAX > txFee {
+	switch {
+	case ops.FeeMode(common.FeeFromAmount) == common.FeeOnTop:
+		// The imported amount is left untouched and the tx fee is paid from
+		// this chain's UTXOs.
+		toBurn := map[ids.ID]uint64{
+			avaxAssetID: txFee,
+		}
+		var err error
+		inputs, outputs, err = b.spend(toBurn, ops)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+		}
+	case importedAVAX > txFee:
 		importedAmounts[avaxAssetID] -= txFee
-	} else {
+	default:
 		if importedAVAX < txFee { // imported amount goes toward paying tx fee
 			toBurn := map[ids.ID]uint64{
 				avaxAssetID: txFee - importedAVAX,
@@ -475,6 +504,15 @@ func (b *builder) NewExportTx(
 	outputs []*avax.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
+	ops := common.NewOptions(options)
+	if ops.FeeMode(common.FeeOnTop) == common.FeeFromAmount {
+		var err error
+		outputs, err = common.DeductFee(outputs, b.context.AVAXAssetID, b.context.BaseTxFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	toBurn := map[ids.ID]uint64{
 		b.context.AVAXAssetID: b.context.BaseTxFee,
 	}
@@ -487,7 +525,6 @@ func (b *builder) NewExportTx(
 		toBurn[assetID] = amountToBurn
 	}
 
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -93,6 +93,58 @@ func TestBaseTx(t *testing.T) {
 	require.Equal(outputsToMove[0], outs[1])
 }
 
+func TestBaseTxFeeFromAmount(t *testing.T) {
+	var (
+		require = require.New(t)
+
+		// backend
+		utxosKey       = testKeys[1]
+		utxos          = makeTestUTXOs(utxosKey)
+		genericBackend = common.NewDeterministicChainUTXOs(
+			require,
+			map[ids.ID][]*avax.UTXO{
+				xChainID: utxos,
+			},
+		)
+		backend = NewBackend(testContext, genericBackend)
+
+		// builder
+		utxoAddr = utxosKey.Address()
+		builder  = builder.New(set.Of(utxoAddr), testContext, backend)
+
+		// data to build the transaction
+		amountToMove  = 7 * units.Avax
+		outputsToMove = []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amountToMove,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{utxoAddr},
+				},
+			},
+		}}
+	)
+
+	utx, err := builder.NewBaseTx(
+		outputsToMove,
+		common.WithFeeMode(common.FeeFromAmount),
+	)
+	require.NoError(err)
+
+	// check UTXOs selection and fee financing
+	ins := utx.Ins
+	outs := utx.Outs
+	require.Len(ins, 2)
+	require.Len(outs, 2)
+
+	// the fee is deducted from the moved amount, so only the moved amount is
+	// consumed from the inputs
+	consumed := ins[0].In.Amount() + ins[1].In.Amount() - outs[0].Out.Amount()
+	require.Equal(amountToMove, consumed)
+	require.Equal(amountToMove-testContext.BaseTxFee, outs[1].Out.Amount())
+}
+
 func TestCreateAssetTx(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	ErrNoFeeOutput      = errors.New("no output to deduct the fee from")
+	ErrFeeExceedsAmount = errors.New("fee exceeds output amount")
+)
+
+// FeeMode specifies whether the tx fee is paid in addition to, or deducted
+// from, the amount being moved by a transaction.
+type FeeMode byte
+
+const (
+	// DefaultFeeMode uses the fee mode the builder applies to the transaction
+	// type. Import transactions deduct the fee from the imported amount, all
+	// other transactions add the fee on top of the moved amount.
+	DefaultFeeMode FeeMode = iota
+	// FeeOnTop pays the fee from additional UTXOs, leaving the moved amount
+	// untouched.
+	FeeOnTop
+	// FeeFromAmount deducts the fee from the moved amount.
+	FeeFromAmount
+)
+
+// DeductFee returns a copy of [outputs] where [fee] has been deducted from the
+// first secp256k1fx transfer output of [assetID]. The provided outputs are not
+// modified.
+func DeductFee(
+	outputs []*avax.TransferableOutput,
+	assetID ids.ID,
+	fee uint64,
+) ([]*avax.TransferableOutput, error) {
+	newOutputs := make([]*avax.TransferableOutput, len(outputs))
+	copy(newOutputs, outputs)
+	for i, out := range outputs {
+		if out.AssetID() != assetID {
+			continue
+		}
+		transferOut, ok := out.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		// The remaining amount must be non-zero for the output to be valid.
+		if transferOut.Amt <= fee {
+			return nil, fmt.Errorf(
+				"%w: fee (%d) >= amount (%d)",
+				ErrFeeExceedsAmount,
+				fee,
+				transferOut.Amt,
+			)
+		}
+
+		newOut := *transferOut
+		newOut.Amt -= fee
+		newOutputs[i] = &avax.TransferableOutput{
+			Asset: out.Asset,
+			FxID:  out.FxID,
+			Out:   &newOut,
+		}
+		return newOutputs, nil
+	}
+	return nil, fmt.Errorf("%w of asset %s", ErrNoFeeOutput, assetID)
+}
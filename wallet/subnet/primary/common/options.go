@@ -41,6 +41,8 @@ type Options struct {
 
 	changeOwner *secp256k1fx.OutputOwners
 
+	defaultRewardsOwner *secp256k1fx.OutputOwners
+
 	memo []byte
 
 	assumeDecided bool
@@ -49,6 +51,10 @@ type Options struct {
 	pollFrequency    time.Duration
 
 	postIssuanceFunc PostIssuanceFunc
+
+	feeMode FeeMode
+
+	utxoSelection UTXOSelection
 }
 
 func NewOptions(ops []Option) *Options {
@@ -117,6 +123,15 @@ func (o *Options) ChangeOwner(defaultOwner *secp256k1fx.OutputOwners) *secp256k1
 	return defaultOwner
 }
 
+// RewardsOwner returns [owner] if it is specified and the default rewards owner
+// otherwise.
+func (o *Options) RewardsOwner(owner *secp256k1fx.OutputOwners) *secp256k1fx.OutputOwners {
+	if owner != nil {
+		return owner
+	}
+	return o.defaultRewardsOwner
+}
+
 func (o *Options) Memo() []byte {
 	return o.memo
 }
@@ -136,6 +151,17 @@ func (o *Options) PostIssuanceFunc() PostIssuanceFunc {
 	return o.postIssuanceFunc
 }
 
+func (o *Options) FeeMode(defaultMode FeeMode) FeeMode {
+	if o.feeMode != DefaultFeeMode {
+		return o.feeMode
+	}
+	return defaultMode
+}
+
+func (o *Options) UTXOSelection() UTXOSelection {
+	return o.utxoSelection
+}
+
 func WithContext(ctx context.Context) Option {
 	return func(o *Options) {
 		o.ctx = ctx
@@ -181,6 +207,14 @@ func WithChangeOwner(changeOwner *secp256k1fx.OutputOwners) Option {
 	}
 }
 
+// WithDefaultRewardsOwner specifies the owner of the rewards of validators that
+// are added without a rewards owner.
+func WithDefaultRewardsOwner(rewardsOwner *secp256k1fx.OutputOwners) Option {
+	return func(o *Options) {
+		o.defaultRewardsOwner = rewardsOwner
+	}
+}
+
 func WithMemo(memo []byte) Option {
 	return func(o *Options) {
 		o.memo = memo
@@ -205,3 +239,19 @@ func WithPostIssuanceFunc(f PostIssuanceFunc) Option {
 		o.postIssuanceFunc = f
 	}
 }
+
+// WithFeeMode specifies how the tx fee is paid for transactions that move a
+// user specified amount.
+func WithFeeMode(mode FeeMode) Option {
+	return func(o *Options) {
+		o.feeMode = mode
+	}
+}
+
+// WithUTXOSelection specifies the order in which UTXOs are consumed to fund the
+// transaction.
+func WithUTXOSelection(selection UTXOSelection) Option {
+	return func(o *Options) {
+		o.utxoSelection = selection
+	}
+}
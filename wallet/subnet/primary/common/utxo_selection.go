@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+// UTXOSelection specifies the order in which a builder consumes UTXOs to fund
+// a transaction.
+type UTXOSelection byte
+
+const (
+	// DefaultUTXOSelection consumes UTXOs in the order of their IDs. This
+	// effectively selects arbitrary UTXOs, which doesn't reveal anything about
+	// the wallet's holdings, but may produce more inputs and more change than
+	// necessary.
+	DefaultUTXOSelection UTXOSelection = iota
+	// LargestFirst consumes the largest UTXOs first. This minimizes the number
+	// of inputs, and therefore the size of the transaction, but tends to
+	// produce large change outputs and leaves the small UTXOs of the wallet
+	// unspent.
+	LargestFirst
+	// BestFit consumes the smallest UTXO that covers the needed amount on its
+	// own. If no single UTXO suffices, the largest UTXOs are consumed first.
+	// This minimizes the change produced by the transaction, and therefore the
+	// fragmentation of the wallet's UTXOs, at the cost of revealing more about
+	// which UTXOs are controlled by the wallet.
+	BestFit
+)
@@ -5,12 +5,16 @@ package primary
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/wallet/chain/c"
 	"github.com/ava-labs/avalanchego/wallet/chain/p"
 	"github.com/ava-labs/avalanchego/wallet/chain/x"
@@ -22,7 +26,11 @@ import (
 	xsigner "github.com/ava-labs/avalanchego/wallet/chain/x/signer"
 )
 
-var _ Wallet = (*wallet)(nil)
+var (
+	_ Wallet = (*wallet)(nil)
+
+	errInvalidRewardAddress = errors.New("invalid reward address")
+)
 
 // Wallet provides chain wallets for the primary network.
 type Wallet interface {
@@ -79,6 +87,9 @@ type WalletConfig struct {
 	// Set of P-chain transactions that the wallet should fetch to be able to
 	// generate transactions.
 	PChainTxsToFetch set.Set[ids.ID] // optional
+	// P-chain address that receives the rewards of validators added without
+	// a rewards owner.
+	DefaultRewardAddress string // optional
 }
 
 // MakeWallet returns a wallet that supports issuing transactions to the chains
@@ -98,6 +109,18 @@ func MakeWallet(ctx context.Context, config *WalletConfig) (Wallet, error) {
 		return nil, err
 	}
 
+	var pOptions []common.Option
+	if config.DefaultRewardAddress != "" {
+		rewardAddr, err := parsePChainAddress(config.DefaultRewardAddress, avaxState.PCTX.NetworkID)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", errInvalidRewardAddress, config.DefaultRewardAddress, err)
+		}
+		pOptions = append(pOptions, common.WithDefaultRewardsOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{rewardAddr},
+		}))
+	}
+
 	ethAddrs := config.EthKeychain.EthAddresses()
 	ethState, err := FetchEthState(ctx, config.URI, ethAddrs)
 	if err != nil {
@@ -139,8 +162,27 @@ func MakeWallet(ctx context.Context, config *WalletConfig) (Wallet, error) {
 	cSigner := c.NewSigner(config.AVAXKeychain, config.EthKeychain, cBackend)
 
 	return NewWallet(
-		p.NewWallet(pBuilder, pSigner, avaxState.PClient, pBackend),
+		p.NewWalletWithOptions(
+			p.NewWallet(pBuilder, pSigner, avaxState.PClient, pBackend),
+			pOptions...,
+		),
 		x.NewWallet(xBuilder, xSigner, avaxState.XClient, xBackend),
 		c.NewWallet(cBuilder, cSigner, avaxState.CClient, ethState.Client, cBackend),
 	), nil
 }
+
+// parsePChainAddress parses [addrStr] as a P-chain address of the network with
+// ID [networkID].
+func parsePChainAddress(addrStr string, networkID uint32) (ids.ShortID, error) {
+	chainIDAlias, hrp, addrBytes, err := address.Parse(addrStr)
+	if err != nil {
+		return ids.ShortEmpty, err
+	}
+	if chainIDAlias != "P" {
+		return ids.ShortEmpty, fmt.Errorf("expected chain alias P but got %q", chainIDAlias)
+	}
+	if expectedHRP := constants.GetHRP(networkID); hrp != expectedHRP {
+		return ids.ShortEmpty, fmt.Errorf("expected hrp %q but got %q", expectedHRP, hrp)
+	}
+	return ids.ToShortID(addrBytes)
+}